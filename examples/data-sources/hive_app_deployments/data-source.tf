@@ -0,0 +1,9 @@
+data "hive_app_deployments" "unused" {
+  name         = "example-service"
+  status       = "active"
+  unused_since = "30d"
+}
+
+output "unused_versions" {
+  value = [for app in data.hive_app_deployments.unused.app_deployments : app.version]
+}
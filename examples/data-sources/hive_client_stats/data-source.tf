@@ -0,0 +1,10 @@
+data "hive_client_stats" "web" {
+  client = "web"
+  period = "7d"
+}
+
+output "web_versions" {
+  value = {
+    for v in one(data.hive_client_stats.web.clients[*].versions) : v.version => v.count
+  }
+}
@@ -0,0 +1,14 @@
+data "hive_operations_stats" "last_hour" {
+  period = "1h"
+}
+
+check "error_rate" {
+  assert {
+    condition     = data.hive_operations_stats.last_hour.failure_rate < 1
+    error_message = "More than 1% of the requests failed in the last hour."
+  }
+}
+
+output "p95_latency" {
+  value = data.hive_operations_stats.last_hour.duration.p95
+}
@@ -0,0 +1,5 @@
+data "hive_organization" "current" {}
+
+output "operations_limit_reached" {
+  value = data.hive_organization.current.limited_for_operations
+}
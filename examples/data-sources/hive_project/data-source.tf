@@ -0,0 +1,7 @@
+data "hive_project" "example" {
+  project = "example-project"
+}
+
+output "project_type" {
+  value = data.hive_project.example.type
+}
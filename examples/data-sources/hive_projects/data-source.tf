@@ -0,0 +1,6 @@
+data "hive_projects" "all" {
+}
+
+output "federated_projects" {
+  value = [for p in data.hive_projects.all.projects : p.slug if p.type == "FEDERATION"]
+}
@@ -0,0 +1,8 @@
+data "hive_schema_checks" "failed" {
+  failed = true
+  limit  = 20
+}
+
+output "failed_schema_checks" {
+  value = [for check in data.hive_schema_checks.failed.schema_checks : check.url]
+}
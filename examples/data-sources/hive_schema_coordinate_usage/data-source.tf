@@ -0,0 +1,18 @@
+data "hive_schema_coordinate_usage" "deprecated" {
+  coordinates = ["Query.legacyProducts", "Product.oldPrice"]
+  period      = "30d"
+}
+
+resource "hive_schema_publish" "example" {
+  service = "products"
+  commit  = "57ee05c"
+  url     = "https://products.example.com/graphql"
+  schema  = file("schema.graphql")
+
+  lifecycle {
+    precondition {
+      condition     = alltrue([for u in data.hive_schema_coordinate_usage.deprecated.usage : !u.is_used])
+      error_message = "The deprecated fields are still in use."
+    }
+  }
+}
@@ -0,0 +1,10 @@
+data "hive_schema_policy_rules" "all" {}
+
+locals {
+  recommended_rules = [for rule in data.hive_schema_policy_rules.all.rules : rule.id if rule.recommended]
+
+  rule_config_schemas = {
+    for rule in data.hive_schema_policy_rules.all.rules : rule.id => jsondecode(rule.config_json_schema)
+    if rule.config_json_schema != null
+  }
+}
@@ -0,0 +1,10 @@
+data "hive_schema_version" "latest" {
+}
+
+output "supergraph" {
+  value = data.hive_schema_version.latest.supergraph
+}
+
+output "subgraph_urls" {
+  value = { for s in data.hive_schema_version.latest.schemas : s.service => s.url }
+}
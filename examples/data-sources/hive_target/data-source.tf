@@ -0,0 +1,8 @@
+data "hive_target" "production" {
+  project = "example-project"
+  target  = "production"
+}
+
+output "cdn_url" {
+  value = data.hive_target.production.cdn_url
+}
@@ -0,0 +1,7 @@
+data "hive_targets" "all" {
+  project = "example-project"
+}
+
+output "cdn_urls" {
+  value = { for t in data.hive_targets.all.targets : t.slug => t.cdn_url }
+}
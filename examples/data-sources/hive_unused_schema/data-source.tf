@@ -0,0 +1,11 @@
+data "hive_unused_schema" "example" {
+  period = "30d"
+}
+
+output "removable_deprecated_fields" {
+  value = [for d in data.hive_unused_schema.example.deprecated : d.coordinate if !d.is_used]
+}
+
+output "unused_types" {
+  value = data.hive_unused_schema.example.unused_types
+}
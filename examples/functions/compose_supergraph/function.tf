@@ -0,0 +1,23 @@
+locals {
+  composition = provider::hive::compose_supergraph({
+    users = {
+      sdl = file("subgraphs/users.graphql")
+      url = "https://users.example.com/graphql"
+    }
+    reviews = {
+      sdl = file("subgraphs/reviews.graphql")
+      url = "https://reviews.example.com/graphql"
+    }
+  })
+}
+
+check "subgraphs_compose" {
+  assert {
+    condition     = length(local.composition.errors) == 0
+    error_message = join("\n", [for e in local.composition.errors : "${e.code}: ${e.message}"])
+  }
+}
+
+output "supergraph" {
+  value = local.composition.supergraph
+}
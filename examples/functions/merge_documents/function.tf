@@ -0,0 +1,10 @@
+resource "hive_app_create" "storefront" {
+  name    = "storefront"
+  version = "1.0.0"
+
+  # The web and mobile clients share one app, so their manifests are merged.
+  documents = provider::hive::merge_documents(
+    file("web/persisted-documents.json"),
+    file("mobile/persisted-query-manifest.json"),
+  )
+}
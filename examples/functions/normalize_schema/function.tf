@@ -0,0 +1,4 @@
+resource "terraform_data" "schema" {
+  # Only replaced when the schema changes, not when it is reformatted.
+  triggers_replace = sha256(provider::hive::normalize_schema(file("schema.graphql")))
+}
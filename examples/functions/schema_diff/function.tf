@@ -0,0 +1,13 @@
+locals {
+  schema_changes = provider::hive::schema_diff(
+    file("schema.previous.graphql"),
+    file("schema.graphql"),
+  )
+}
+
+check "no_breaking_schema_changes" {
+  assert {
+    condition     = length([for c in local.schema_changes : c if c.criticality == "Breaking"]) == 0
+    error_message = join("\n", [for c in local.schema_changes : c.message if c.criticality == "Breaking"])
+  }
+}
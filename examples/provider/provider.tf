@@ -1,3 +1,12 @@
 provider "hive" {
-  token = "<registry token>" # or the HIVE_TOKEN environment variable
+  # Falls back to token_file, the HIVE_TOKEN environment variable or the
+  # accessToken in the hive.json of the Hive CLI.
+  token = "<registry token>"
+
+  # Fall back to the HIVE_ORGANIZATION, HIVE_PROJECT and HIVE_TARGET
+  # environment variables. Resources and data sources can override the
+  # project and target.
+  organization = "my-organization"
+  project      = "my-project"
+  target       = "production"
 }
@@ -2,4 +2,11 @@ resource "hive_app_create" "example" {
   name      = "example-service"
   version   = "1.0.0"
   documents = file("persisted-documents.json")
+
+  # Fail when a key in the documents file doesn't match its document
+  hash_mode      = "verify"
+  hash_algorithm = "sha256"
+
+  # Fail at plan time when an operation doesn't validate against the schema
+  validate_documents = true
 }
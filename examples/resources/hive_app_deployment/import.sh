@@ -0,0 +1,2 @@
+# App deployments can be imported using the app name and version.
+terraform import hive_app_deployment.example example-service/1.0.0
@@ -0,0 +1,6 @@
+resource "hive_app_deployment" "example" {
+  name      = "example-service"
+  version   = "1.0.0"
+  documents = file("persisted-documents.json")
+  activate  = true
+}
@@ -0,0 +1,5 @@
+resource "hive_app_deployment_retention" "example" {
+  name             = "example-service"
+  keep_latest      = 5
+  keep_used_within = "30d"
+}
@@ -0,0 +1,2 @@
+# Organization settings can be imported using the organization slug.
+terraform import hive_organization_settings.settings my-organization
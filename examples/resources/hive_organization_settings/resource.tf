@@ -0,0 +1,4 @@
+resource "hive_organization_settings" "settings" {
+  slug                     = "my-organization"
+  monthly_operations_limit = 1000000
+}
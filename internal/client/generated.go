@@ -6,6 +6,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 )
@@ -233,6 +234,14 @@ func (v *AddDocumentsToAppDeploymentResponse) GetAddDocumentsToAppDeployment() A
 	return v.AddDocumentsToAppDeployment
 }
 
+// AppDeploymentResponse is returned by AppDeployment on success.
+type AppDeploymentResponse struct {
+	Target *AppDeploymentTarget `json:"target"`
+}
+
+// GetTarget returns AppDeploymentResponse.Target, and is useful for accessing the field via an interface.
+func (v *AppDeploymentResponse) GetTarget() *AppDeploymentTarget { return v.Target }
+
 type AppDeploymentStatus string
 
 const (
@@ -247,213 +256,243 @@ var AllAppDeploymentStatus = []AppDeploymentStatus{
 	AppDeploymentStatusRetired,
 }
 
-// CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult includes the requested fields of the GraphQL type CreateAppDeploymentResult.
-type CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult struct {
-	Ok    *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk       `json:"ok"`
-	Error *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError `json:"error"`
+// AppDeploymentTarget includes the requested fields of the GraphQL type Target.
+type AppDeploymentTarget struct {
+	Id            string                            `json:"id"`
+	AppDeployment *AppDeploymentTargetAppDeployment `json:"appDeployment"`
 }
 
-// GetOk returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult.Ok, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult) GetOk() *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk {
-	return v.Ok
-}
+// GetId returns AppDeploymentTarget.Id, and is useful for accessing the field via an interface.
+func (v *AppDeploymentTarget) GetId() string { return v.Id }
 
-// GetError returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult.Error, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult) GetError() *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError {
-	return v.Error
+// GetAppDeployment returns AppDeploymentTarget.AppDeployment, and is useful for accessing the field via an interface.
+func (v *AppDeploymentTarget) GetAppDeployment() *AppDeploymentTargetAppDeployment {
+	return v.AppDeployment
 }
 
-// CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError includes the requested fields of the GraphQL type CreateAppDeploymentError.
-type CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError struct {
-	Message string `json:"message"`
+// AppDeploymentTargetAppDeployment includes the requested fields of the GraphQL type AppDeployment.
+type AppDeploymentTargetAppDeployment struct {
+	Id      string              `json:"id"`
+	Name    string              `json:"name"`
+	Version string              `json:"version"`
+	Status  AppDeploymentStatus `json:"status"`
 }
 
-// GetMessage returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError.Message, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError) GetMessage() string {
-	return v.Message
-}
+// GetId returns AppDeploymentTargetAppDeployment.Id, and is useful for accessing the field via an interface.
+func (v *AppDeploymentTargetAppDeployment) GetId() string { return v.Id }
 
-// CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk includes the requested fields of the GraphQL type CreateAppDeploymentOk.
-type CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk struct {
-	CreatedAppDeployment CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment `json:"createdAppDeployment"`
+// GetName returns AppDeploymentTargetAppDeployment.Name, and is useful for accessing the field via an interface.
+func (v *AppDeploymentTargetAppDeployment) GetName() string { return v.Name }
+
+// GetVersion returns AppDeploymentTargetAppDeployment.Version, and is useful for accessing the field via an interface.
+func (v *AppDeploymentTargetAppDeployment) GetVersion() string { return v.Version }
+
+// GetStatus returns AppDeploymentTargetAppDeployment.Status, and is useful for accessing the field via an interface.
+func (v *AppDeploymentTargetAppDeployment) GetStatus() AppDeploymentStatus { return v.Status }
+
+// AppDeploymentsResponse is returned by AppDeployments on success.
+type AppDeploymentsResponse struct {
+	Target *AppDeploymentsTarget `json:"target"`
 }
 
-// GetCreatedAppDeployment returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk.CreatedAppDeployment, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk) GetCreatedAppDeployment() CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment {
-	return v.CreatedAppDeployment
+// GetTarget returns AppDeploymentsResponse.Target, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsResponse) GetTarget() *AppDeploymentsTarget { return v.Target }
+
+// AppDeploymentsTarget includes the requested fields of the GraphQL type Target.
+type AppDeploymentsTarget struct {
+	Id string `json:"id"`
+	// The app deployments for this target.
+	AppDeployments *AppDeploymentsTargetAppDeploymentsAppDeploymentConnection `json:"appDeployments"`
 }
 
-// CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment includes the requested fields of the GraphQL type AppDeployment.
-type CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment struct {
-	Id      string              `json:"id"`
-	Name    string              `json:"name"`
-	Version string              `json:"version"`
-	Status  AppDeploymentStatus `json:"status"`
+// GetId returns AppDeploymentsTarget.Id, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTarget) GetId() string { return v.Id }
+
+// GetAppDeployments returns AppDeploymentsTarget.AppDeployments, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTarget) GetAppDeployments() *AppDeploymentsTargetAppDeploymentsAppDeploymentConnection {
+	return v.AppDeployments
 }
 
-// GetId returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment.Id, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment) GetId() string {
-	return v.Id
+// AppDeploymentsTargetAppDeploymentsAppDeploymentConnection includes the requested fields of the GraphQL type AppDeploymentConnection.
+type AppDeploymentsTargetAppDeploymentsAppDeploymentConnection struct {
+	Edges    []AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdge `json:"edges"`
+	PageInfo AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionPageInfo                 `json:"pageInfo"`
 }
 
-// GetName returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment.Name, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment) GetName() string {
-	return v.Name
+// GetEdges returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnection.Edges, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnection) GetEdges() []AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdge {
+	return v.Edges
 }
 
-// GetVersion returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment.Version, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment) GetVersion() string {
-	return v.Version
+// GetPageInfo returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnection) GetPageInfo() AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionPageInfo {
+	return v.PageInfo
 }
 
-// GetStatus returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment.Status, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment) GetStatus() AppDeploymentStatus {
-	return v.Status
+// AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdge includes the requested fields of the GraphQL type AppDeploymentEdge.
+type AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdge struct {
+	Node AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment `json:"node"`
 }
 
-type CreateAppDeploymentInput struct {
-	AppName    string                `json:"appName"`
-	AppVersion string                `json:"appVersion"`
-	Target     *TargetReferenceInput `json:"target,omitempty"`
+// GetNode returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdge.Node, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdge) GetNode() AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment {
+	return v.Node
 }
 
-// GetAppName returns CreateAppDeploymentInput.AppName, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentInput) GetAppName() string { return v.AppName }
+// AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment includes the requested fields of the GraphQL type AppDeployment.
+type AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment struct {
+	Id                 string              `json:"id"`
+	Name               string              `json:"name"`
+	Version            string              `json:"version"`
+	Status             AppDeploymentStatus `json:"status"`
+	TotalDocumentCount int                 `json:"totalDocumentCount"`
+	// The last time a GraphQL request that used the app deployment was reported.
+	LastUsed *time.Time `json:"lastUsed"`
+}
 
-// GetAppVersion returns CreateAppDeploymentInput.AppVersion, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentInput) GetAppVersion() string { return v.AppVersion }
+// GetId returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment.Id, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment) GetId() string {
+	return v.Id
+}
 
-// GetTarget returns CreateAppDeploymentInput.Target, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentInput) GetTarget() *TargetReferenceInput { return v.Target }
+// GetName returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment.Name, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment) GetName() string {
+	return v.Name
+}
 
-// CreateAppDeploymentResponse is returned by CreateAppDeployment on success.
-type CreateAppDeploymentResponse struct {
-	CreateAppDeployment CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult `json:"createAppDeployment"`
+// GetVersion returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment.Version, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment) GetVersion() string {
+	return v.Version
 }
 
-// GetCreateAppDeployment returns CreateAppDeploymentResponse.CreateAppDeployment, and is useful for accessing the field via an interface.
-func (v *CreateAppDeploymentResponse) GetCreateAppDeployment() CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult {
-	return v.CreateAppDeployment
+// GetStatus returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment.Status, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment) GetStatus() AppDeploymentStatus {
+	return v.Status
 }
 
-type DocumentInput struct {
-	// GraphQL operation body.
-	Body string `json:"body"`
-	// GraphQL operation hash.
-	Hash string `json:"hash"`
+// GetTotalDocumentCount returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment.TotalDocumentCount, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment) GetTotalDocumentCount() int {
+	return v.TotalDocumentCount
 }
 
-// GetBody returns DocumentInput.Body, and is useful for accessing the field via an interface.
-func (v *DocumentInput) GetBody() string { return v.Body }
+// GetLastUsed returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment.LastUsed, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionEdgesAppDeploymentEdgeNodeAppDeployment) GetLastUsed() *time.Time {
+	return v.LastUsed
+}
 
-// GetHash returns DocumentInput.Hash, and is useful for accessing the field via an interface.
-func (v *DocumentInput) GetHash() string { return v.Hash }
+// AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
 
-type GitHubSchemaCheckInput struct {
-	Commit string `json:"commit"`
-	// The pull request number of the schema check.
-	PullRequestNumber string `json:"pullRequestNumber"`
-	// The repository name of the schema check.
-	Repository string `json:"repository"`
+// GetHasNextPage returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
 }
 
-// GetCommit returns GitHubSchemaCheckInput.Commit, and is useful for accessing the field via an interface.
-func (v *GitHubSchemaCheckInput) GetCommit() string { return v.Commit }
+// GetEndCursor returns AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *AppDeploymentsTargetAppDeploymentsAppDeploymentConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
 
-// GetPullRequestNumber returns GitHubSchemaCheckInput.PullRequestNumber, and is useful for accessing the field via an interface.
-func (v *GitHubSchemaCheckInput) GetPullRequestNumber() string { return v.PullRequestNumber }
+type BillingPlanType string
 
-// GetRepository returns GitHubSchemaCheckInput.Repository, and is useful for accessing the field via an interface.
-func (v *GitHubSchemaCheckInput) GetRepository() string { return v.Repository }
+const (
+	BillingPlanTypeEnterprise BillingPlanType = "ENTERPRISE"
+	BillingPlanTypeHobby      BillingPlanType = "HOBBY"
+	BillingPlanTypePro        BillingPlanType = "PRO"
+)
 
-type SchemaCheckInput struct {
-	// Optional context ID to group schema checks together.
-	// Manually approved breaking changes will be memorized for schema checks with the same context id.
-	ContextId string                  `json:"contextId,omitempty"`
-	Github    *GitHubSchemaCheckInput `json:"github,omitempty"`
-	Meta      *SchemaCheckMetaInput   `json:"meta,omitempty"`
-	Sdl       string                  `json:"sdl"`
-	Service   string                  `json:"service"`
-	Target    *TargetReferenceInput   `json:"target,omitempty"`
+var AllBillingPlanType = []BillingPlanType{
+	BillingPlanTypeEnterprise,
+	BillingPlanTypeHobby,
+	BillingPlanTypePro,
 }
 
-// GetContextId returns SchemaCheckInput.ContextId, and is useful for accessing the field via an interface.
-func (v *SchemaCheckInput) GetContextId() string { return v.ContextId }
-
-// GetGithub returns SchemaCheckInput.Github, and is useful for accessing the field via an interface.
-func (v *SchemaCheckInput) GetGithub() *GitHubSchemaCheckInput { return v.Github }
+type BreakingChangeFormula string
 
-// GetMeta returns SchemaCheckInput.Meta, and is useful for accessing the field via an interface.
-func (v *SchemaCheckInput) GetMeta() *SchemaCheckMetaInput { return v.Meta }
+const (
+	BreakingChangeFormulaPercentage   BreakingChangeFormula = "PERCENTAGE"
+	BreakingChangeFormulaRequestCount BreakingChangeFormula = "REQUEST_COUNT"
+)
 
-// GetSdl returns SchemaCheckInput.Sdl, and is useful for accessing the field via an interface.
-func (v *SchemaCheckInput) GetSdl() string { return v.Sdl }
+var AllBreakingChangeFormula = []BreakingChangeFormula{
+	BreakingChangeFormulaPercentage,
+	BreakingChangeFormulaRequestCount,
+}
 
-// GetService returns SchemaCheckInput.Service, and is useful for accessing the field via an interface.
-func (v *SchemaCheckInput) GetService() string { return v.Service }
+// ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnection includes the requested fields of the GraphQL type ClientStatsValuesConnection.
+type ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnection struct {
+	Nodes []ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues `json:"nodes"`
+}
 
-// GetTarget returns SchemaCheckInput.Target, and is useful for accessing the field via an interface.
-func (v *SchemaCheckInput) GetTarget() *TargetReferenceInput { return v.Target }
+// GetNodes returns ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnection) GetNodes() []ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues {
+	return v.Nodes
+}
 
-type SchemaCheckMetaInput struct {
-	Author string `json:"author"`
-	Commit string `json:"commit"`
+// ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues includes the requested fields of the GraphQL type ClientStatsValues.
+type ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues struct {
+	ClientStatsDetails `json:"-"`
 }
 
-// GetAuthor returns SchemaCheckMetaInput.Author, and is useful for accessing the field via an interface.
-func (v *SchemaCheckMetaInput) GetAuthor() string { return v.Author }
+// GetName returns ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues.Name, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues) GetName() string {
+	return v.ClientStatsDetails.Name
+}
 
-// GetCommit returns SchemaCheckMetaInput.Commit, and is useful for accessing the field via an interface.
-func (v *SchemaCheckMetaInput) GetCommit() string { return v.Commit }
+// GetCount returns ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues.Count, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues) GetCount() float64 {
+	return v.ClientStatsDetails.Count
+}
 
-// SchemaCheckResponse is returned by SchemaCheck on success.
-type SchemaCheckResponse struct {
-	SchemaCheck SchemaCheckSchemaCheckSchemaCheckPayload `json:"-"`
+// GetPercentage returns ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues.Percentage, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues) GetPercentage() float64 {
+	return v.ClientStatsDetails.Percentage
 }
 
-// GetSchemaCheck returns SchemaCheckResponse.SchemaCheck, and is useful for accessing the field via an interface.
-func (v *SchemaCheckResponse) GetSchemaCheck() SchemaCheckSchemaCheckSchemaCheckPayload {
-	return v.SchemaCheck
+// GetVersions returns ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues.Versions, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues) GetVersions() []ClientStatsDetailsVersionsClientVersionStatsValues {
+	return v.ClientStatsDetails.Versions
 }
 
-func (v *SchemaCheckResponse) UnmarshalJSON(b []byte) error {
+func (v *ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*SchemaCheckResponse
-		SchemaCheck json.RawMessage `json:"schemaCheck"`
+		*ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.SchemaCheckResponse = v
+	firstPass.ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	{
-		dst := &v.SchemaCheck
-		src := firstPass.SchemaCheck
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalSchemaCheckSchemaCheckSchemaCheckPayload(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal SchemaCheckResponse.SchemaCheck: %w", err)
-			}
-		}
+	err = json.Unmarshal(
+		b, &v.ClientStatsDetails)
+	if err != nil {
+		return err
 	}
 	return nil
 }
 
-type __premarshalSchemaCheckResponse struct {
-	SchemaCheck json.RawMessage `json:"schemaCheck"`
+type __premarshalClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues struct {
+	Name string `json:"name"`
+
+	Count float64 `json:"count"`
+
+	Percentage float64 `json:"percentage"`
+
+	Versions []ClientStatsDetailsVersionsClientVersionStatsValues `json:"versions"`
 }
 
-func (v *SchemaCheckResponse) MarshalJSON() ([]byte, error) {
+func (v *ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -461,116 +500,162 @@ func (v *SchemaCheckResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *SchemaCheckResponse) __premarshalJSON() (*__premarshalSchemaCheckResponse, error) {
-	var retval __premarshalSchemaCheckResponse
-
-	{
+func (v *ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues) __premarshalJSON() (*__premarshalClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues, error) {
+	var retval __premarshalClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnectionNodesClientStatsValues
 
-		dst := &retval.SchemaCheck
-		src := v.SchemaCheck
-		var err error
-		*dst, err = __marshalSchemaCheckSchemaCheckSchemaCheckPayload(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal SchemaCheckResponse.SchemaCheck: %w", err)
-		}
-	}
+	retval.Name = v.ClientStatsDetails.Name
+	retval.Count = v.ClientStatsDetails.Count
+	retval.Percentage = v.ClientStatsDetails.Percentage
+	retval.Versions = v.ClientStatsDetails.Versions
 	return &retval, nil
 }
 
-// SchemaCheckSchemaCheckGitHubSchemaCheckError includes the requested fields of the GraphQL type GitHubSchemaCheckError.
-type SchemaCheckSchemaCheckGitHubSchemaCheckError struct {
-	Typename string `json:"__typename"`
-	Message  string `json:"message"`
+type ClientStatsByTargetsInput struct {
+	OrganizationSlug string         `json:"organizationSlug"`
+	Period           DateRangeInput `json:"period"`
+	ProjectSlug      string         `json:"projectSlug"`
+	TargetIds        []string       `json:"targetIds"`
 }
 
-// GetTypename returns SchemaCheckSchemaCheckGitHubSchemaCheckError.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckGitHubSchemaCheckError) GetTypename() string { return v.Typename }
+// GetOrganizationSlug returns ClientStatsByTargetsInput.OrganizationSlug, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsInput) GetOrganizationSlug() string { return v.OrganizationSlug }
 
-// GetMessage returns SchemaCheckSchemaCheckGitHubSchemaCheckError.Message, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckGitHubSchemaCheckError) GetMessage() string { return v.Message }
+// GetPeriod returns ClientStatsByTargetsInput.Period, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsInput) GetPeriod() DateRangeInput { return v.Period }
 
-// SchemaCheckSchemaCheckGitHubSchemaCheckSuccess includes the requested fields of the GraphQL type GitHubSchemaCheckSuccess.
-type SchemaCheckSchemaCheckGitHubSchemaCheckSuccess struct {
-	Typename string `json:"__typename"`
-	Message  string `json:"message"`
+// GetProjectSlug returns ClientStatsByTargetsInput.ProjectSlug, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsInput) GetProjectSlug() string { return v.ProjectSlug }
+
+// GetTargetIds returns ClientStatsByTargetsInput.TargetIds, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsInput) GetTargetIds() []string { return v.TargetIds }
+
+// ClientStatsByTargetsResponse is returned by ClientStatsByTargets on success.
+type ClientStatsByTargetsResponse struct {
+	ClientStatsByTargets ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnection `json:"clientStatsByTargets"`
 }
 
-// GetTypename returns SchemaCheckSchemaCheckGitHubSchemaCheckSuccess.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckGitHubSchemaCheckSuccess) GetTypename() string { return v.Typename }
+// GetClientStatsByTargets returns ClientStatsByTargetsResponse.ClientStatsByTargets, and is useful for accessing the field via an interface.
+func (v *ClientStatsByTargetsResponse) GetClientStatsByTargets() ClientStatsByTargetsClientStatsByTargetsClientStatsValuesConnection {
+	return v.ClientStatsByTargets
+}
 
-// GetMessage returns SchemaCheckSchemaCheckGitHubSchemaCheckSuccess.Message, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckGitHubSchemaCheckSuccess) GetMessage() string { return v.Message }
+// ClientStatsClientStats includes the requested fields of the GraphQL type ClientStats.
+type ClientStatsClientStats struct {
+	TotalRequests int64                                                          `json:"totalRequests"`
+	TotalVersions int64                                                          `json:"totalVersions"`
+	Operations    ClientStatsClientStatsOperationsOperationStatsValuesConnection `json:"operations"`
+}
 
-// SchemaCheckSchemaCheckSchemaCheckError includes the requested fields of the GraphQL type SchemaCheckError.
-type SchemaCheckSchemaCheckSchemaCheckError struct {
-	Typename    string                                                            `json:"__typename"`
-	Valid       bool                                                              `json:"valid"`
-	Errors      SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection `json:"errors"`
-	SchemaCheck SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck                 `json:"-"`
+// GetTotalRequests returns ClientStatsClientStats.TotalRequests, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStats) GetTotalRequests() int64 { return v.TotalRequests }
+
+// GetTotalVersions returns ClientStatsClientStats.TotalVersions, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStats) GetTotalVersions() int64 { return v.TotalVersions }
+
+// GetOperations returns ClientStatsClientStats.Operations, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStats) GetOperations() ClientStatsClientStatsOperationsOperationStatsValuesConnection {
+	return v.Operations
 }
 
-// GetTypename returns SchemaCheckSchemaCheckSchemaCheckError.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckError) GetTypename() string { return v.Typename }
+// ClientStatsClientStatsOperationsOperationStatsValuesConnection includes the requested fields of the GraphQL type OperationStatsValuesConnection.
+type ClientStatsClientStatsOperationsOperationStatsValuesConnection struct {
+	Nodes []ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues `json:"nodes"`
+}
 
-// GetValid returns SchemaCheckSchemaCheckSchemaCheckError.Valid, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckError) GetValid() bool { return v.Valid }
+// GetNodes returns ClientStatsClientStatsOperationsOperationStatsValuesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnection) GetNodes() []ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues {
+	return v.Nodes
+}
 
-// GetErrors returns SchemaCheckSchemaCheckSchemaCheckError.Errors, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckError) GetErrors() SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection {
-	return v.Errors
+// ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues includes the requested fields of the GraphQL type OperationStatsValues.
+type ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues struct {
+	OperationStatsDetails `json:"-"`
 }
 
-// GetSchemaCheck returns SchemaCheckSchemaCheckSchemaCheckError.SchemaCheck, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckError) GetSchemaCheck() SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck {
-	return v.SchemaCheck
+// GetId returns ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Id, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetId() string {
+	return v.OperationStatsDetails.Id
 }
 
-func (v *SchemaCheckSchemaCheckSchemaCheckError) UnmarshalJSON(b []byte) error {
+// GetName returns ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Name, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetName() string {
+	return v.OperationStatsDetails.Name
+}
+
+// GetKind returns ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Kind, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetKind() string {
+	return v.OperationStatsDetails.Kind
+}
+
+// GetOperationHash returns ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.OperationHash, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetOperationHash() *string {
+	return v.OperationStatsDetails.OperationHash
+}
+
+// GetCount returns ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Count, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetCount() int64 {
+	return v.OperationStatsDetails.Count
+}
+
+// GetCountOk returns ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.CountOk, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetCountOk() int64 {
+	return v.OperationStatsDetails.CountOk
+}
+
+// GetPercentage returns ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Percentage, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetPercentage() float64 {
+	return v.OperationStatsDetails.Percentage
+}
+
+// GetDuration returns ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Duration, and is useful for accessing the field via an interface.
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetDuration() OperationStatsDetailsDurationDurationValues {
+	return v.OperationStatsDetails.Duration
+}
+
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*SchemaCheckSchemaCheckSchemaCheckError
-		SchemaCheck json.RawMessage `json:"schemaCheck"`
+		*ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.SchemaCheckSchemaCheckSchemaCheckError = v
+	firstPass.ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	{
-		dst := &v.SchemaCheck
-		src := firstPass.SchemaCheck
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal SchemaCheckSchemaCheckSchemaCheckError.SchemaCheck: %w", err)
-			}
-		}
+	err = json.Unmarshal(
+		b, &v.OperationStatsDetails)
+	if err != nil {
+		return err
 	}
 	return nil
 }
 
-type __premarshalSchemaCheckSchemaCheckSchemaCheckError struct {
-	Typename string `json:"__typename"`
+type __premarshalClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues struct {
+	Id string `json:"id"`
 
-	Valid bool `json:"valid"`
+	Name string `json:"name"`
 
-	Errors SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection `json:"errors"`
+	Kind string `json:"kind"`
 
-	SchemaCheck json.RawMessage `json:"schemaCheck"`
+	OperationHash *string `json:"operationHash"`
+
+	Count int64 `json:"count"`
+
+	CountOk int64 `json:"countOk"`
+
+	Percentage float64 `json:"percentage"`
+
+	Duration OperationStatsDetailsDurationDurationValues `json:"duration"`
 }
 
-func (v *SchemaCheckSchemaCheckSchemaCheckError) MarshalJSON() ([]byte, error) {
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -578,360 +663,328 @@ func (v *SchemaCheckSchemaCheckSchemaCheckError) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *SchemaCheckSchemaCheckSchemaCheckError) __premarshalJSON() (*__premarshalSchemaCheckSchemaCheckSchemaCheckError, error) {
-	var retval __premarshalSchemaCheckSchemaCheckSchemaCheckError
-
-	retval.Typename = v.Typename
-	retval.Valid = v.Valid
-	retval.Errors = v.Errors
-	{
+func (v *ClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) __premarshalJSON() (*__premarshalClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues, error) {
+	var retval __premarshalClientStatsClientStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues
 
-		dst := &retval.SchemaCheck
-		src := v.SchemaCheck
-		var err error
-		*dst, err = __marshalSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal SchemaCheckSchemaCheckSchemaCheckError.SchemaCheck: %w", err)
-		}
-	}
+	retval.Id = v.OperationStatsDetails.Id
+	retval.Name = v.OperationStatsDetails.Name
+	retval.Kind = v.OperationStatsDetails.Kind
+	retval.OperationHash = v.OperationStatsDetails.OperationHash
+	retval.Count = v.OperationStatsDetails.Count
+	retval.CountOk = v.OperationStatsDetails.CountOk
+	retval.Percentage = v.OperationStatsDetails.Percentage
+	retval.Duration = v.OperationStatsDetails.Duration
 	return &retval, nil
 }
 
-// SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection includes the requested fields of the GraphQL type SchemaErrorConnection.
-type SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection struct {
-	Nodes []SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError `json:"nodes"`
-	Total int                                                                                 `json:"total"`
+// ClientStatsDetails includes the GraphQL fields of ClientStatsValues requested by the fragment ClientStatsDetails.
+type ClientStatsDetails struct {
+	Name       string                                               `json:"name"`
+	Count      float64                                              `json:"count"`
+	Percentage float64                                              `json:"percentage"`
+	Versions   []ClientStatsDetailsVersionsClientVersionStatsValues `json:"versions"`
 }
 
-// GetNodes returns SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection) GetNodes() []SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError {
-	return v.Nodes
-}
+// GetName returns ClientStatsDetails.Name, and is useful for accessing the field via an interface.
+func (v *ClientStatsDetails) GetName() string { return v.Name }
 
-// GetTotal returns SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection.Total, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection) GetTotal() int {
-	return v.Total
-}
+// GetCount returns ClientStatsDetails.Count, and is useful for accessing the field via an interface.
+func (v *ClientStatsDetails) GetCount() float64 { return v.Count }
 
-// SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError includes the requested fields of the GraphQL type SchemaError.
-type SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError struct {
-	Message string `json:"message"`
-}
+// GetPercentage returns ClientStatsDetails.Percentage, and is useful for accessing the field via an interface.
+func (v *ClientStatsDetails) GetPercentage() float64 { return v.Percentage }
 
-// GetMessage returns SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError.Message, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError) GetMessage() string {
-	return v.Message
+// GetVersions returns ClientStatsDetails.Versions, and is useful for accessing the field via an interface.
+func (v *ClientStatsDetails) GetVersions() []ClientStatsDetailsVersionsClientVersionStatsValues {
+	return v.Versions
 }
 
-// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck includes the requested fields of the GraphQL interface SchemaCheck.
-//
-// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck is implemented by the following types:
-// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck
-// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck
-type SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck interface {
-	implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
-	// GetId returns the interface-field "id" from its implementation.
-	GetId() string
-	// GetWebUrl returns the interface-field "webUrl" from its implementation.
-	// The GraphQL interface field's documentation follows.
-	//
-	// The URL of the schema check on the Hive Web App.
-	GetWebUrl() string
+// ClientStatsDetailsVersionsClientVersionStatsValues includes the requested fields of the GraphQL type ClientVersionStatsValues.
+type ClientStatsDetailsVersionsClientVersionStatsValues struct {
+	Version    string  `json:"version"`
+	Count      float64 `json:"count"`
+	Percentage float64 `json:"percentage"`
 }
 
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck() {
+// GetVersion returns ClientStatsDetailsVersionsClientVersionStatsValues.Version, and is useful for accessing the field via an interface.
+func (v *ClientStatsDetailsVersionsClientVersionStatsValues) GetVersion() string { return v.Version }
+
+// GetCount returns ClientStatsDetailsVersionsClientVersionStatsValues.Count, and is useful for accessing the field via an interface.
+func (v *ClientStatsDetailsVersionsClientVersionStatsValues) GetCount() float64 { return v.Count }
+
+// GetPercentage returns ClientStatsDetailsVersionsClientVersionStatsValues.Percentage, and is useful for accessing the field via an interface.
+func (v *ClientStatsDetailsVersionsClientVersionStatsValues) GetPercentage() float64 {
+	return v.Percentage
 }
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck() {
+
+type ClientStatsInput struct {
+	Client           string         `json:"client"`
+	OrganizationSlug string         `json:"organizationSlug"`
+	Period           DateRangeInput `json:"period"`
+	ProjectSlug      string         `json:"projectSlug"`
+	TargetSlug       string         `json:"targetSlug"`
 }
 
-func __unmarshalSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck(b []byte, v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck) error {
-	if string(b) == "null" {
-		return nil
-	}
+// GetClient returns ClientStatsInput.Client, and is useful for accessing the field via an interface.
+func (v *ClientStatsInput) GetClient() string { return v.Client }
 
-	var tn struct {
-		TypeName string `json:"__typename"`
-	}
-	err := json.Unmarshal(b, &tn)
-	if err != nil {
-		return err
-	}
+// GetOrganizationSlug returns ClientStatsInput.OrganizationSlug, and is useful for accessing the field via an interface.
+func (v *ClientStatsInput) GetOrganizationSlug() string { return v.OrganizationSlug }
 
-	switch tn.TypeName {
-	case "FailedSchemaCheck":
-		*v = new(SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck)
-		return json.Unmarshal(b, *v)
-	case "SuccessfulSchemaCheck":
-		*v = new(SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing SchemaCheck.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck: "%v"`, tn.TypeName)
-	}
-}
+// GetPeriod returns ClientStatsInput.Period, and is useful for accessing the field via an interface.
+func (v *ClientStatsInput) GetPeriod() DateRangeInput { return v.Period }
 
-func __marshalSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck(v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck) ([]byte, error) {
+// GetProjectSlug returns ClientStatsInput.ProjectSlug, and is useful for accessing the field via an interface.
+func (v *ClientStatsInput) GetProjectSlug() string { return v.ProjectSlug }
 
-	var typename string
-	switch v := (*v).(type) {
-	case *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck:
-		typename = "FailedSchemaCheck"
+// GetTargetSlug returns ClientStatsInput.TargetSlug, and is useful for accessing the field via an interface.
+func (v *ClientStatsInput) GetTargetSlug() string { return v.TargetSlug }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck
-		}{typename, v}
-		return json.Marshal(result)
-	case *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck:
-		typename = "SuccessfulSchemaCheck"
+// ClientStatsResponse is returned by ClientStats on success.
+type ClientStatsResponse struct {
+	ClientStats ClientStatsClientStats `json:"clientStats"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck: "%T"`, v)
-	}
+// GetClientStats returns ClientStatsResponse.ClientStats, and is useful for accessing the field via an interface.
+func (v *ClientStatsResponse) GetClientStats() ClientStatsClientStats { return v.ClientStats }
+
+// CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult includes the requested fields of the GraphQL type CreateAppDeploymentResult.
+type CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult struct {
+	Ok    *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk       `json:"ok"`
+	Error *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError `json:"error"`
 }
 
-// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck includes the requested fields of the GraphQL type FailedSchemaCheck.
-// The GraphQL type's documentation follows.
-//
-// A failed schema check.
-type SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck struct {
-	Typename string `json:"__typename"`
-	Id       string `json:"id"`
-	// The URL of the schema check on the Hive Web App.
-	WebUrl string `json:"webUrl"`
+// GetOk returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult.Ok, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult) GetOk() *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk {
+	return v.Ok
 }
 
-// GetTypename returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck) GetTypename() string {
-	return v.Typename
+// GetError returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult.Error, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult) GetError() *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError {
+	return v.Error
 }
 
-// GetId returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck.Id, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck) GetId() string {
-	return v.Id
+// CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError includes the requested fields of the GraphQL type CreateAppDeploymentError.
+type CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError struct {
+	Message string `json:"message"`
 }
 
-// GetWebUrl returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck) GetWebUrl() string {
-	return v.WebUrl
+// GetMessage returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError.Message, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultErrorCreateAppDeploymentError) GetMessage() string {
+	return v.Message
 }
 
-// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck includes the requested fields of the GraphQL type SuccessfulSchemaCheck.
-// The GraphQL type's documentation follows.
-//
-// A successful schema check.
-type SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck struct {
-	Typename string `json:"__typename"`
-	Id       string `json:"id"`
-	// The URL of the schema check on the Hive Web App.
-	WebUrl string `json:"webUrl"`
+// CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk includes the requested fields of the GraphQL type CreateAppDeploymentOk.
+type CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk struct {
+	CreatedAppDeployment CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment `json:"createdAppDeployment"`
 }
 
-// GetTypename returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck) GetTypename() string {
-	return v.Typename
+// GetCreatedAppDeployment returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk.CreatedAppDeployment, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOk) GetCreatedAppDeployment() CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment {
+	return v.CreatedAppDeployment
 }
 
-// GetId returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck.Id, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck) GetId() string {
+// CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment includes the requested fields of the GraphQL type AppDeployment.
+type CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment struct {
+	Id      string              `json:"id"`
+	Name    string              `json:"name"`
+	Version string              `json:"version"`
+	Status  AppDeploymentStatus `json:"status"`
+}
+
+// GetId returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment.Id, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment) GetId() string {
 	return v.Id
 }
 
-// GetWebUrl returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck) GetWebUrl() string {
-	return v.WebUrl
+// GetName returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment.Name, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment) GetName() string {
+	return v.Name
 }
 
-// SchemaCheckSchemaCheckSchemaCheckPayload includes the requested fields of the GraphQL interface SchemaCheckPayload.
-//
-// SchemaCheckSchemaCheckSchemaCheckPayload is implemented by the following types:
-// SchemaCheckSchemaCheckGitHubSchemaCheckError
-// SchemaCheckSchemaCheckGitHubSchemaCheckSuccess
-// SchemaCheckSchemaCheckSchemaCheckError
-// SchemaCheckSchemaCheckSchemaCheckSuccess
-type SchemaCheckSchemaCheckSchemaCheckPayload interface {
-	implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
+// GetVersion returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment.Version, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment) GetVersion() string {
+	return v.Version
 }
 
-func (v *SchemaCheckSchemaCheckGitHubSchemaCheckError) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload() {
+// GetStatus returns CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment.Status, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResultOkCreateAppDeploymentOkCreatedAppDeployment) GetStatus() AppDeploymentStatus {
+	return v.Status
 }
-func (v *SchemaCheckSchemaCheckGitHubSchemaCheckSuccess) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload() {
+
+type CreateAppDeploymentInput struct {
+	AppName    string                `json:"appName"`
+	AppVersion string                `json:"appVersion"`
+	Target     *TargetReferenceInput `json:"target,omitempty"`
 }
-func (v *SchemaCheckSchemaCheckSchemaCheckError) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload() {
+
+// GetAppName returns CreateAppDeploymentInput.AppName, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentInput) GetAppName() string { return v.AppName }
+
+// GetAppVersion returns CreateAppDeploymentInput.AppVersion, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentInput) GetAppVersion() string { return v.AppVersion }
+
+// GetTarget returns CreateAppDeploymentInput.Target, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentInput) GetTarget() *TargetReferenceInput { return v.Target }
+
+// CreateAppDeploymentResponse is returned by CreateAppDeployment on success.
+type CreateAppDeploymentResponse struct {
+	CreateAppDeployment CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult `json:"createAppDeployment"`
 }
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload() {
+
+// GetCreateAppDeployment returns CreateAppDeploymentResponse.CreateAppDeployment, and is useful for accessing the field via an interface.
+func (v *CreateAppDeploymentResponse) GetCreateAppDeployment() CreateAppDeploymentCreateAppDeploymentCreateAppDeploymentResult {
+	return v.CreateAppDeployment
 }
 
-func __unmarshalSchemaCheckSchemaCheckSchemaCheckPayload(b []byte, v *SchemaCheckSchemaCheckSchemaCheckPayload) error {
-	if string(b) == "null" {
-		return nil
-	}
+type DateRangeInput struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
 
-	var tn struct {
-		TypeName string `json:"__typename"`
-	}
-	err := json.Unmarshal(b, &tn)
-	if err != nil {
-		return err
-	}
+// GetFrom returns DateRangeInput.From, and is useful for accessing the field via an interface.
+func (v *DateRangeInput) GetFrom() time.Time { return v.From }
 
-	switch tn.TypeName {
-	case "GitHubSchemaCheckError":
-		*v = new(SchemaCheckSchemaCheckGitHubSchemaCheckError)
-		return json.Unmarshal(b, *v)
-	case "GitHubSchemaCheckSuccess":
-		*v = new(SchemaCheckSchemaCheckGitHubSchemaCheckSuccess)
-		return json.Unmarshal(b, *v)
-	case "SchemaCheckError":
-		*v = new(SchemaCheckSchemaCheckSchemaCheckError)
-		return json.Unmarshal(b, *v)
-	case "SchemaCheckSuccess":
-		*v = new(SchemaCheckSchemaCheckSchemaCheckSuccess)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing SchemaCheckPayload.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckPayload: "%v"`, tn.TypeName)
-	}
+// GetTo returns DateRangeInput.To, and is useful for accessing the field via an interface.
+func (v *DateRangeInput) GetTo() time.Time { return v.To }
+
+type DocumentInput struct {
+	// GraphQL operation body.
+	Body string `json:"body"`
+	// GraphQL operation hash.
+	Hash string `json:"hash"`
 }
 
-func __marshalSchemaCheckSchemaCheckSchemaCheckPayload(v *SchemaCheckSchemaCheckSchemaCheckPayload) ([]byte, error) {
+// GetBody returns DocumentInput.Body, and is useful for accessing the field via an interface.
+func (v *DocumentInput) GetBody() string { return v.Body }
 
-	var typename string
-	switch v := (*v).(type) {
-	case *SchemaCheckSchemaCheckGitHubSchemaCheckError:
-		typename = "GitHubSchemaCheckError"
+// GetHash returns DocumentInput.Hash, and is useful for accessing the field via an interface.
+func (v *DocumentInput) GetHash() string { return v.Hash }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaCheckSchemaCheckGitHubSchemaCheckError
-		}{typename, v}
-		return json.Marshal(result)
-	case *SchemaCheckSchemaCheckGitHubSchemaCheckSuccess:
-		typename = "GitHubSchemaCheckSuccess"
+// DurationDetails includes the GraphQL fields of DurationValues requested by the fragment DurationDetails.
+type DurationDetails struct {
+	Avg int `json:"avg"`
+	P75 int `json:"p75"`
+	P90 int `json:"p90"`
+	P95 int `json:"p95"`
+	P99 int `json:"p99"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaCheckSchemaCheckGitHubSchemaCheckSuccess
-		}{typename, v}
-		return json.Marshal(result)
-	case *SchemaCheckSchemaCheckSchemaCheckError:
-		typename = "SchemaCheckError"
+// GetAvg returns DurationDetails.Avg, and is useful for accessing the field via an interface.
+func (v *DurationDetails) GetAvg() int { return v.Avg }
 
-		premarshaled, err := v.__premarshalJSON()
-		if err != nil {
-			return nil, err
-		}
-		result := struct {
-			TypeName string `json:"__typename"`
-			*__premarshalSchemaCheckSchemaCheckSchemaCheckError
-		}{typename, premarshaled}
-		return json.Marshal(result)
-	case *SchemaCheckSchemaCheckSchemaCheckSuccess:
-		typename = "SchemaCheckSuccess"
+// GetP75 returns DurationDetails.P75, and is useful for accessing the field via an interface.
+func (v *DurationDetails) GetP75() int { return v.P75 }
 
-		premarshaled, err := v.__premarshalJSON()
-		if err != nil {
-			return nil, err
-		}
-		result := struct {
-			TypeName string `json:"__typename"`
-			*__premarshalSchemaCheckSchemaCheckSchemaCheckSuccess
-		}{typename, premarshaled}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckPayload: "%T"`, v)
-	}
+// GetP90 returns DurationDetails.P90, and is useful for accessing the field via an interface.
+func (v *DurationDetails) GetP90() int { return v.P90 }
+
+// GetP95 returns DurationDetails.P95, and is useful for accessing the field via an interface.
+func (v *DurationDetails) GetP95() int { return v.P95 }
+
+// GetP99 returns DurationDetails.P99, and is useful for accessing the field via an interface.
+func (v *DurationDetails) GetP99() int { return v.P99 }
+
+// ExplorerField includes the GraphQL fields of GraphQLField requested by the fragment ExplorerField.
+type ExplorerField struct {
+	Name              string                                  `json:"name"`
+	IsDeprecated      bool                                    `json:"isDeprecated"`
+	DeprecationReason *string                                 `json:"deprecationReason"`
+	Usage             ExplorerFieldUsageSchemaCoordinateUsage `json:"usage"`
+	Args              []ExplorerFieldArgsGraphQLArgument      `json:"args"`
 }
 
-// SchemaCheckSchemaCheckSchemaCheckSuccess includes the requested fields of the GraphQL type SchemaCheckSuccess.
-type SchemaCheckSchemaCheckSchemaCheckSuccess struct {
-	Typename    string                                              `json:"__typename"`
-	Valid       bool                                                `json:"valid"`
-	Initial     bool                                                `json:"initial"`
-	SchemaCheck SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck `json:"-"`
+// GetName returns ExplorerField.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerField) GetName() string { return v.Name }
+
+// GetIsDeprecated returns ExplorerField.IsDeprecated, and is useful for accessing the field via an interface.
+func (v *ExplorerField) GetIsDeprecated() bool { return v.IsDeprecated }
+
+// GetDeprecationReason returns ExplorerField.DeprecationReason, and is useful for accessing the field via an interface.
+func (v *ExplorerField) GetDeprecationReason() *string { return v.DeprecationReason }
+
+// GetUsage returns ExplorerField.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerField) GetUsage() ExplorerFieldUsageSchemaCoordinateUsage { return v.Usage }
+
+// GetArgs returns ExplorerField.Args, and is useful for accessing the field via an interface.
+func (v *ExplorerField) GetArgs() []ExplorerFieldArgsGraphQLArgument { return v.Args }
+
+// ExplorerFieldArgsGraphQLArgument includes the requested fields of the GraphQL type GraphQLArgument.
+type ExplorerFieldArgsGraphQLArgument struct {
+	Name              string                                                     `json:"name"`
+	IsDeprecated      bool                                                       `json:"isDeprecated"`
+	DeprecationReason *string                                                    `json:"deprecationReason"`
+	Usage             ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage `json:"usage"`
 }
 
-// GetTypename returns SchemaCheckSchemaCheckSchemaCheckSuccess.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) GetTypename() string { return v.Typename }
+// GetName returns ExplorerFieldArgsGraphQLArgument.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldArgsGraphQLArgument) GetName() string { return v.Name }
 
-// GetValid returns SchemaCheckSchemaCheckSchemaCheckSuccess.Valid, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) GetValid() bool { return v.Valid }
+// GetIsDeprecated returns ExplorerFieldArgsGraphQLArgument.IsDeprecated, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldArgsGraphQLArgument) GetIsDeprecated() bool { return v.IsDeprecated }
 
-// GetInitial returns SchemaCheckSchemaCheckSchemaCheckSuccess.Initial, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) GetInitial() bool { return v.Initial }
+// GetDeprecationReason returns ExplorerFieldArgsGraphQLArgument.DeprecationReason, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldArgsGraphQLArgument) GetDeprecationReason() *string { return v.DeprecationReason }
 
-// GetSchemaCheck returns SchemaCheckSchemaCheckSchemaCheckSuccess.SchemaCheck, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) GetSchemaCheck() SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck {
-	return v.SchemaCheck
+// GetUsage returns ExplorerFieldArgsGraphQLArgument.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldArgsGraphQLArgument) GetUsage() ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage {
+	return v.Usage
 }
 
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) UnmarshalJSON(b []byte) error {
+// ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage includes the requested fields of the GraphQL type SchemaCoordinateUsage.
+type ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage struct {
+	ExplorerUsage `json:"-"`
+}
+
+// GetIsUsed returns ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage.IsUsed, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage) GetIsUsed() bool {
+	return v.ExplorerUsage.IsUsed
+}
+
+// GetTotal returns ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage.Total, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage) GetTotal() float64 {
+	return v.ExplorerUsage.Total
+}
+
+// GetUsedByClients returns ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage.UsedByClients, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage) GetUsedByClients() []string {
+	return v.ExplorerUsage.UsedByClients
+}
+
+func (v *ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*SchemaCheckSchemaCheckSchemaCheckSuccess
-		SchemaCheck json.RawMessage `json:"schemaCheck"`
+		*ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.SchemaCheckSchemaCheckSchemaCheckSuccess = v
+	firstPass.ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	{
-		dst := &v.SchemaCheck
-		src := firstPass.SchemaCheck
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal SchemaCheckSchemaCheckSchemaCheckSuccess.SchemaCheck: %w", err)
-			}
-		}
+	err = json.Unmarshal(
+		b, &v.ExplorerUsage)
+	if err != nil {
+		return err
 	}
 	return nil
 }
 
-type __premarshalSchemaCheckSchemaCheckSchemaCheckSuccess struct {
-	Typename string `json:"__typename"`
-
-	Valid bool `json:"valid"`
+type __premarshalExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage struct {
+	IsUsed bool `json:"isUsed"`
 
-	Initial bool `json:"initial"`
+	Total float64 `json:"total"`
 
-	SchemaCheck json.RawMessage `json:"schemaCheck"`
+	UsedByClients []string `json:"usedByClients"`
 }
 
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) MarshalJSON() ([]byte, error) {
+func (v *ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -939,51 +992,104 @@ func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) MarshalJSON() ([]byte, error)
 	return json.Marshal(premarshaled)
 }
 
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) __premarshalJSON() (*__premarshalSchemaCheckSchemaCheckSchemaCheckSuccess, error) {
-	var retval __premarshalSchemaCheckSchemaCheckSchemaCheckSuccess
+func (v *ExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage) __premarshalJSON() (*__premarshalExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage, error) {
+	var retval __premarshalExplorerFieldArgsGraphQLArgumentUsageSchemaCoordinateUsage
 
-	retval.Typename = v.Typename
-	retval.Valid = v.Valid
-	retval.Initial = v.Initial
-	{
+	retval.IsUsed = v.ExplorerUsage.IsUsed
+	retval.Total = v.ExplorerUsage.Total
+	retval.UsedByClients = v.ExplorerUsage.UsedByClients
+	return &retval, nil
+}
 
-		dst := &retval.SchemaCheck
-		src := v.SchemaCheck
-		var err error
-		*dst, err = __marshalSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal SchemaCheckSchemaCheckSchemaCheckSuccess.SchemaCheck: %w", err)
-		}
+// ExplorerFieldUsageSchemaCoordinateUsage includes the requested fields of the GraphQL type SchemaCoordinateUsage.
+type ExplorerFieldUsageSchemaCoordinateUsage struct {
+	ExplorerUsage `json:"-"`
+}
+
+// GetIsUsed returns ExplorerFieldUsageSchemaCoordinateUsage.IsUsed, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldUsageSchemaCoordinateUsage) GetIsUsed() bool { return v.ExplorerUsage.IsUsed }
+
+// GetTotal returns ExplorerFieldUsageSchemaCoordinateUsage.Total, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldUsageSchemaCoordinateUsage) GetTotal() float64 { return v.ExplorerUsage.Total }
+
+// GetUsedByClients returns ExplorerFieldUsageSchemaCoordinateUsage.UsedByClients, and is useful for accessing the field via an interface.
+func (v *ExplorerFieldUsageSchemaCoordinateUsage) GetUsedByClients() []string {
+	return v.ExplorerUsage.UsedByClients
+}
+
+func (v *ExplorerFieldUsageSchemaCoordinateUsage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ExplorerFieldUsageSchemaCoordinateUsage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ExplorerFieldUsageSchemaCoordinateUsage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerUsage)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalExplorerFieldUsageSchemaCoordinateUsage struct {
+	IsUsed bool `json:"isUsed"`
+
+	Total float64 `json:"total"`
+
+	UsedByClients []string `json:"usedByClients"`
+}
+
+func (v *ExplorerFieldUsageSchemaCoordinateUsage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
 	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ExplorerFieldUsageSchemaCoordinateUsage) __premarshalJSON() (*__premarshalExplorerFieldUsageSchemaCoordinateUsage, error) {
+	var retval __premarshalExplorerFieldUsageSchemaCoordinateUsage
+
+	retval.IsUsed = v.ExplorerUsage.IsUsed
+	retval.Total = v.ExplorerUsage.Total
+	retval.UsedByClients = v.ExplorerUsage.UsedByClients
 	return &retval, nil
 }
 
-// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck includes the requested fields of the GraphQL interface SchemaCheck.
+// ExplorerNamedType includes the GraphQL fields of GraphQLNamedType requested by the fragment ExplorerNamedType.
 //
-// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck is implemented by the following types:
-// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck
-// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck
-type SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck interface {
-	implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck()
+// ExplorerNamedType is implemented by the following types:
+// ExplorerNamedTypeGraphQLEnumType
+// ExplorerNamedTypeGraphQLInputObjectType
+// ExplorerNamedTypeGraphQLInterfaceType
+// ExplorerNamedTypeGraphQLObjectType
+// ExplorerNamedTypeGraphQLScalarType
+// ExplorerNamedTypeGraphQLUnionType
+type ExplorerNamedType interface {
+	implementsGraphQLInterfaceExplorerNamedType()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() string
-	// GetId returns the interface-field "id" from its implementation.
-	GetId() string
-	// GetWebUrl returns the interface-field "webUrl" from its implementation.
-	// The GraphQL interface field's documentation follows.
-	//
-	// The URL of the schema check on the Hive Web App.
-	GetWebUrl() string
 }
 
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck() {
-}
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck() {
-}
+func (v *ExplorerNamedTypeGraphQLEnumType) implementsGraphQLInterfaceExplorerNamedType()        {}
+func (v *ExplorerNamedTypeGraphQLInputObjectType) implementsGraphQLInterfaceExplorerNamedType() {}
+func (v *ExplorerNamedTypeGraphQLInterfaceType) implementsGraphQLInterfaceExplorerNamedType()   {}
+func (v *ExplorerNamedTypeGraphQLObjectType) implementsGraphQLInterfaceExplorerNamedType()      {}
+func (v *ExplorerNamedTypeGraphQLScalarType) implementsGraphQLInterfaceExplorerNamedType()      {}
+func (v *ExplorerNamedTypeGraphQLUnionType) implementsGraphQLInterfaceExplorerNamedType()       {}
 
-func __unmarshalSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck(b []byte, v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck) error {
+func __unmarshalExplorerNamedType(b []byte, v *ExplorerNamedType) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -997,219 +1103,255 @@ func __unmarshalSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck(b []byte, v
 	}
 
 	switch tn.TypeName {
-	case "FailedSchemaCheck":
-		*v = new(SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck)
+	case "GraphQLEnumType":
+		*v = new(ExplorerNamedTypeGraphQLEnumType)
 		return json.Unmarshal(b, *v)
-	case "SuccessfulSchemaCheck":
-		*v = new(SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck)
+	case "GraphQLInputObjectType":
+		*v = new(ExplorerNamedTypeGraphQLInputObjectType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLInterfaceType":
+		*v = new(ExplorerNamedTypeGraphQLInterfaceType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLObjectType":
+		*v = new(ExplorerNamedTypeGraphQLObjectType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLScalarType":
+		*v = new(ExplorerNamedTypeGraphQLScalarType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLUnionType":
+		*v = new(ExplorerNamedTypeGraphQLUnionType)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing SchemaCheck.__typename")
+			"response was missing GraphQLNamedType.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck: "%v"`, tn.TypeName)
+			`unexpected concrete type for ExplorerNamedType: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck(v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck) ([]byte, error) {
+func __marshalExplorerNamedType(v *ExplorerNamedType) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck:
-		typename = "FailedSchemaCheck"
+	case *ExplorerNamedTypeGraphQLEnumType:
+		typename = "GraphQLEnumType"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck
+			*ExplorerNamedTypeGraphQLEnumType
 		}{typename, v}
 		return json.Marshal(result)
-	case *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck:
-		typename = "SuccessfulSchemaCheck"
+	case *ExplorerNamedTypeGraphQLInputObjectType:
+		typename = "GraphQLInputObjectType"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck
+			*ExplorerNamedTypeGraphQLInputObjectType
+		}{typename, v}
+		return json.Marshal(result)
+	case *ExplorerNamedTypeGraphQLInterfaceType:
+		typename = "GraphQLInterfaceType"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ExplorerNamedTypeGraphQLInterfaceType
+		}{typename, v}
+		return json.Marshal(result)
+	case *ExplorerNamedTypeGraphQLObjectType:
+		typename = "GraphQLObjectType"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ExplorerNamedTypeGraphQLObjectType
+		}{typename, v}
+		return json.Marshal(result)
+	case *ExplorerNamedTypeGraphQLScalarType:
+		typename = "GraphQLScalarType"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ExplorerNamedTypeGraphQLScalarType
+		}{typename, v}
+		return json.Marshal(result)
+	case *ExplorerNamedTypeGraphQLUnionType:
+		typename = "GraphQLUnionType"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*ExplorerNamedTypeGraphQLUnionType
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck: "%T"`, v)
+			`unexpected concrete type for ExplorerNamedType: "%T"`, v)
 	}
 }
 
-// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck includes the requested fields of the GraphQL type FailedSchemaCheck.
-// The GraphQL type's documentation follows.
-//
-// A failed schema check.
-type SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck struct {
-	Typename string `json:"__typename"`
-	Id       string `json:"id"`
-	// The URL of the schema check on the Hive Web App.
-	WebUrl string `json:"webUrl"`
+// ExplorerNamedTypeFieldsGraphQLField includes the requested fields of the GraphQL type GraphQLField.
+type ExplorerNamedTypeFieldsGraphQLField struct {
+	ExplorerField `json:"-"`
 }
 
-// GetTypename returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck) GetTypename() string {
-	return v.Typename
+// GetName returns ExplorerNamedTypeFieldsGraphQLField.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLField) GetName() string { return v.ExplorerField.Name }
+
+// GetIsDeprecated returns ExplorerNamedTypeFieldsGraphQLField.IsDeprecated, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLField) GetIsDeprecated() bool {
+	return v.ExplorerField.IsDeprecated
 }
 
-// GetId returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck.Id, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck) GetId() string {
-	return v.Id
+// GetDeprecationReason returns ExplorerNamedTypeFieldsGraphQLField.DeprecationReason, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLField) GetDeprecationReason() *string {
+	return v.ExplorerField.DeprecationReason
 }
 
-// GetWebUrl returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck) GetWebUrl() string {
-	return v.WebUrl
+// GetUsage returns ExplorerNamedTypeFieldsGraphQLField.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLField) GetUsage() ExplorerFieldUsageSchemaCoordinateUsage {
+	return v.ExplorerField.Usage
 }
 
-// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck includes the requested fields of the GraphQL type SuccessfulSchemaCheck.
-// The GraphQL type's documentation follows.
-//
-// A successful schema check.
-type SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck struct {
-	Typename string `json:"__typename"`
-	Id       string `json:"id"`
-	// The URL of the schema check on the Hive Web App.
-	WebUrl string `json:"webUrl"`
+// GetArgs returns ExplorerNamedTypeFieldsGraphQLField.Args, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLField) GetArgs() []ExplorerFieldArgsGraphQLArgument {
+	return v.ExplorerField.Args
 }
 
-// GetTypename returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck) GetTypename() string {
-	return v.Typename
-}
+func (v *ExplorerNamedTypeFieldsGraphQLField) UnmarshalJSON(b []byte) error {
 
-// GetId returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck.Id, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck) GetId() string {
-	return v.Id
-}
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetWebUrl returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
-func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck) GetWebUrl() string {
-	return v.WebUrl
-}
+	var firstPass struct {
+		*ExplorerNamedTypeFieldsGraphQLField
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ExplorerNamedTypeFieldsGraphQLField = v
 
-type SchemaPublishGitHubInput struct {
-	// The commit sha.
-	Commit string `json:"commit"`
-	// The repository name.
-	Repository string `json:"repository"`
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerField)
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
-// GetCommit returns SchemaPublishGitHubInput.Commit, and is useful for accessing the field via an interface.
-func (v *SchemaPublishGitHubInput) GetCommit() string { return v.Commit }
+type __premarshalExplorerNamedTypeFieldsGraphQLField struct {
+	Name string `json:"name"`
 
-// GetRepository returns SchemaPublishGitHubInput.Repository, and is useful for accessing the field via an interface.
-func (v *SchemaPublishGitHubInput) GetRepository() string { return v.Repository }
+	IsDeprecated bool `json:"isDeprecated"`
 
-type SchemaPublishInput struct {
-	Author string `json:"author"`
-	Commit string `json:"commit"`
-	// Accept breaking changes and mark schema as valid (if composable)
-	Experimental_acceptBreakingChanges bool `json:"experimental_acceptBreakingChanges"`
-	Force                              bool `json:"force"`
-	// Link GitHub version to a GitHub commit on a repository.
-	GitHub *SchemaPublishGitHubInput `json:"gitHub,omitempty"`
-	// Talk to GitHub Application and create a check-run
-	Github   bool   `json:"github"`
-	Metadata string `json:"metadata,omitempty"`
-	Sdl      string `json:"sdl"`
-	Service  string `json:"service"`
-	// Whether the CLI supports retrying the schema publish, in case acquiring the schema publish lock fails due to a busy queue.
-	SupportsRetry bool                  `json:"supportsRetry"`
-	Target        *TargetReferenceInput `json:"target,omitempty"`
-	Url           string                `json:"url"`
-}
+	DeprecationReason *string `json:"deprecationReason"`
 
-// GetAuthor returns SchemaPublishInput.Author, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetAuthor() string { return v.Author }
+	Usage ExplorerFieldUsageSchemaCoordinateUsage `json:"usage"`
 
-// GetCommit returns SchemaPublishInput.Commit, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetCommit() string { return v.Commit }
+	Args []ExplorerFieldArgsGraphQLArgument `json:"args"`
+}
 
-// GetExperimental_acceptBreakingChanges returns SchemaPublishInput.Experimental_acceptBreakingChanges, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetExperimental_acceptBreakingChanges() bool {
-	return v.Experimental_acceptBreakingChanges
+func (v *ExplorerNamedTypeFieldsGraphQLField) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetForce returns SchemaPublishInput.Force, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetForce() bool { return v.Force }
+func (v *ExplorerNamedTypeFieldsGraphQLField) __premarshalJSON() (*__premarshalExplorerNamedTypeFieldsGraphQLField, error) {
+	var retval __premarshalExplorerNamedTypeFieldsGraphQLField
 
-// GetGitHub returns SchemaPublishInput.GitHub, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetGitHub() *SchemaPublishGitHubInput { return v.GitHub }
+	retval.Name = v.ExplorerField.Name
+	retval.IsDeprecated = v.ExplorerField.IsDeprecated
+	retval.DeprecationReason = v.ExplorerField.DeprecationReason
+	retval.Usage = v.ExplorerField.Usage
+	retval.Args = v.ExplorerField.Args
+	return &retval, nil
+}
 
-// GetGithub returns SchemaPublishInput.Github, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetGithub() bool { return v.Github }
+// ExplorerNamedTypeFieldsGraphQLInputField includes the requested fields of the GraphQL type GraphQLInputField.
+type ExplorerNamedTypeFieldsGraphQLInputField struct {
+	Name              string                                                             `json:"name"`
+	IsDeprecated      bool                                                               `json:"isDeprecated"`
+	DeprecationReason *string                                                            `json:"deprecationReason"`
+	Usage             ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage `json:"usage"`
+}
 
-// GetMetadata returns SchemaPublishInput.Metadata, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetMetadata() string { return v.Metadata }
+// GetName returns ExplorerNamedTypeFieldsGraphQLInputField.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLInputField) GetName() string { return v.Name }
 
-// GetSdl returns SchemaPublishInput.Sdl, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetSdl() string { return v.Sdl }
+// GetIsDeprecated returns ExplorerNamedTypeFieldsGraphQLInputField.IsDeprecated, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLInputField) GetIsDeprecated() bool { return v.IsDeprecated }
 
-// GetService returns SchemaPublishInput.Service, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetService() string { return v.Service }
+// GetDeprecationReason returns ExplorerNamedTypeFieldsGraphQLInputField.DeprecationReason, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLInputField) GetDeprecationReason() *string {
+	return v.DeprecationReason
+}
 
-// GetSupportsRetry returns SchemaPublishInput.SupportsRetry, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetSupportsRetry() bool { return v.SupportsRetry }
+// GetUsage returns ExplorerNamedTypeFieldsGraphQLInputField.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLInputField) GetUsage() ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage {
+	return v.Usage
+}
 
-// GetTarget returns SchemaPublishInput.Target, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetTarget() *TargetReferenceInput { return v.Target }
+// ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage includes the requested fields of the GraphQL type SchemaCoordinateUsage.
+type ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage struct {
+	ExplorerUsage `json:"-"`
+}
 
-// GetUrl returns SchemaPublishInput.Url, and is useful for accessing the field via an interface.
-func (v *SchemaPublishInput) GetUrl() string { return v.Url }
+// GetIsUsed returns ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage.IsUsed, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage) GetIsUsed() bool {
+	return v.ExplorerUsage.IsUsed
+}
 
-// SchemaPublishResponse is returned by SchemaPublish on success.
-type SchemaPublishResponse struct {
-	SchemaPublish SchemaPublishSchemaPublishSchemaPublishPayload `json:"-"`
+// GetTotal returns ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage.Total, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage) GetTotal() float64 {
+	return v.ExplorerUsage.Total
 }
 
-// GetSchemaPublish returns SchemaPublishResponse.SchemaPublish, and is useful for accessing the field via an interface.
-func (v *SchemaPublishResponse) GetSchemaPublish() SchemaPublishSchemaPublishSchemaPublishPayload {
-	return v.SchemaPublish
+// GetUsedByClients returns ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage.UsedByClients, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage) GetUsedByClients() []string {
+	return v.ExplorerUsage.UsedByClients
 }
 
-func (v *SchemaPublishResponse) UnmarshalJSON(b []byte) error {
+func (v *ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*SchemaPublishResponse
-		SchemaPublish json.RawMessage `json:"schemaPublish"`
+		*ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.SchemaPublishResponse = v
+	firstPass.ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	{
-		dst := &v.SchemaPublish
-		src := firstPass.SchemaPublish
-		if len(src) != 0 && string(src) != "null" {
-			err = __unmarshalSchemaPublishSchemaPublishSchemaPublishPayload(
-				src, dst)
-			if err != nil {
-				return fmt.Errorf(
-					"unable to unmarshal SchemaPublishResponse.SchemaPublish: %w", err)
-			}
-		}
+	err = json.Unmarshal(
+		b, &v.ExplorerUsage)
+	if err != nil {
+		return err
 	}
 	return nil
 }
 
-type __premarshalSchemaPublishResponse struct {
-	SchemaPublish json.RawMessage `json:"schemaPublish"`
+type __premarshalExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage struct {
+	IsUsed bool `json:"isUsed"`
+
+	Total float64 `json:"total"`
+
+	UsedByClients []string `json:"usedByClients"`
 }
 
-func (v *SchemaPublishResponse) MarshalJSON() ([]byte, error) {
+func (v *ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -1217,418 +1359,7366 @@ func (v *SchemaPublishResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *SchemaPublishResponse) __premarshalJSON() (*__premarshalSchemaPublishResponse, error) {
-	var retval __premarshalSchemaPublishResponse
-
-	{
+func (v *ExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage) __premarshalJSON() (*__premarshalExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage, error) {
+	var retval __premarshalExplorerNamedTypeFieldsGraphQLInputFieldUsageSchemaCoordinateUsage
 
-		dst := &retval.SchemaPublish
-		src := v.SchemaPublish
-		var err error
-		*dst, err = __marshalSchemaPublishSchemaPublishSchemaPublishPayload(
-			&src)
-		if err != nil {
-			return nil, fmt.Errorf(
-				"unable to marshal SchemaPublishResponse.SchemaPublish: %w", err)
-		}
-	}
+	retval.IsUsed = v.ExplorerUsage.IsUsed
+	retval.Total = v.ExplorerUsage.Total
+	retval.UsedByClients = v.ExplorerUsage.UsedByClients
 	return &retval, nil
 }
 
-// SchemaPublishSchemaPublishGitHubSchemaPublishError includes the requested fields of the GraphQL type GitHubSchemaPublishError.
-type SchemaPublishSchemaPublishGitHubSchemaPublishError struct {
-	Typename string `json:"__typename"`
-	Message  string `json:"message"`
+// ExplorerNamedType includes the GraphQL fields of GraphQLEnumType requested by the fragment ExplorerNamedType.
+type ExplorerNamedTypeGraphQLEnumType struct {
+	Typename string                                      `json:"__typename"`
+	Name     string                                      `json:"name"`
+	Usage    ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+	Values   []ExplorerNamedTypeValuesGraphQLEnumValue   `json:"values"`
 }
 
-// GetTypename returns SchemaPublishSchemaPublishGitHubSchemaPublishError.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishGitHubSchemaPublishError) GetTypename() string { return v.Typename }
+// GetTypename returns ExplorerNamedTypeGraphQLEnumType.Typename, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLEnumType) GetTypename() string { return v.Typename }
 
-// GetMessage returns SchemaPublishSchemaPublishGitHubSchemaPublishError.Message, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishGitHubSchemaPublishError) GetMessage() string { return v.Message }
+// GetName returns ExplorerNamedTypeGraphQLEnumType.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLEnumType) GetName() string { return v.Name }
 
-// SchemaPublishSchemaPublishGitHubSchemaPublishSuccess includes the requested fields of the GraphQL type GitHubSchemaPublishSuccess.
-type SchemaPublishSchemaPublishGitHubSchemaPublishSuccess struct {
-	Typename string `json:"__typename"`
-	Message  string `json:"message"`
+// GetUsage returns ExplorerNamedTypeGraphQLEnumType.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLEnumType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.Usage
 }
 
-// GetTypename returns SchemaPublishSchemaPublishGitHubSchemaPublishSuccess.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishGitHubSchemaPublishSuccess) GetTypename() string {
-	return v.Typename
+// GetValues returns ExplorerNamedTypeGraphQLEnumType.Values, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLEnumType) GetValues() []ExplorerNamedTypeValuesGraphQLEnumValue {
+	return v.Values
 }
 
-// GetMessage returns SchemaPublishSchemaPublishGitHubSchemaPublishSuccess.Message, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishGitHubSchemaPublishSuccess) GetMessage() string { return v.Message }
-
-// SchemaPublishSchemaPublishSchemaPublishError includes the requested fields of the GraphQL type SchemaPublishError.
-type SchemaPublishSchemaPublishSchemaPublishError struct {
-	Typename      string                                                                  `json:"__typename"`
-	Valid         bool                                                                    `json:"valid"`
-	LinkToWebsite string                                                                  `json:"linkToWebsite"`
-	Errors        SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection `json:"errors"`
+// ExplorerNamedType includes the GraphQL fields of GraphQLInputObjectType requested by the fragment ExplorerNamedType.
+type ExplorerNamedTypeGraphQLInputObjectType struct {
+	Typename string                                      `json:"__typename"`
+	Name     string                                      `json:"name"`
+	Usage    ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+	Fields   []ExplorerNamedTypeFieldsGraphQLInputField  `json:"fields"`
 }
 
-// GetTypename returns SchemaPublishSchemaPublishSchemaPublishError.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishError) GetTypename() string { return v.Typename }
+// GetTypename returns ExplorerNamedTypeGraphQLInputObjectType.Typename, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLInputObjectType) GetTypename() string { return v.Typename }
 
-// GetValid returns SchemaPublishSchemaPublishSchemaPublishError.Valid, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishError) GetValid() bool { return v.Valid }
+// GetName returns ExplorerNamedTypeGraphQLInputObjectType.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLInputObjectType) GetName() string { return v.Name }
 
-// GetLinkToWebsite returns SchemaPublishSchemaPublishSchemaPublishError.LinkToWebsite, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishError) GetLinkToWebsite() string {
-	return v.LinkToWebsite
+// GetUsage returns ExplorerNamedTypeGraphQLInputObjectType.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLInputObjectType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.Usage
 }
 
-// GetErrors returns SchemaPublishSchemaPublishSchemaPublishError.Errors, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishError) GetErrors() SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection {
-	return v.Errors
+// GetFields returns ExplorerNamedTypeGraphQLInputObjectType.Fields, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLInputObjectType) GetFields() []ExplorerNamedTypeFieldsGraphQLInputField {
+	return v.Fields
 }
 
-// SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection includes the requested fields of the GraphQL type SchemaErrorConnection.
-type SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection struct {
-	Nodes []SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError `json:"nodes"`
-	Total int                                                                                       `json:"total"`
+// ExplorerNamedType includes the GraphQL fields of GraphQLInterfaceType requested by the fragment ExplorerNamedType.
+type ExplorerNamedTypeGraphQLInterfaceType struct {
+	Typename string                                      `json:"__typename"`
+	Name     string                                      `json:"name"`
+	Usage    ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+	Fields   []ExplorerNamedTypeFieldsGraphQLField       `json:"fields"`
 }
 
-// GetNodes returns SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection) GetNodes() []SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError {
-	return v.Nodes
-}
+// GetTypename returns ExplorerNamedTypeGraphQLInterfaceType.Typename, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLInterfaceType) GetTypename() string { return v.Typename }
 
-// GetTotal returns SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection.Total, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection) GetTotal() int {
-	return v.Total
-}
+// GetName returns ExplorerNamedTypeGraphQLInterfaceType.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLInterfaceType) GetName() string { return v.Name }
 
-// SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError includes the requested fields of the GraphQL type SchemaError.
-type SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError struct {
-	Message string `json:"message"`
+// GetUsage returns ExplorerNamedTypeGraphQLInterfaceType.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLInterfaceType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.Usage
 }
 
-// GetMessage returns SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError.Message, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError) GetMessage() string {
-	return v.Message
+// GetFields returns ExplorerNamedTypeGraphQLInterfaceType.Fields, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLInterfaceType) GetFields() []ExplorerNamedTypeFieldsGraphQLField {
+	return v.Fields
 }
 
-// SchemaPublishSchemaPublishSchemaPublishMissingServiceError includes the requested fields of the GraphQL type SchemaPublishMissingServiceError.
-type SchemaPublishSchemaPublishSchemaPublishMissingServiceError struct {
-	Typename string `json:"__typename"`
-	Message  string `json:"message"`
+// ExplorerNamedType includes the GraphQL fields of GraphQLObjectType requested by the fragment ExplorerNamedType.
+type ExplorerNamedTypeGraphQLObjectType struct {
+	Typename string                                      `json:"__typename"`
+	Name     string                                      `json:"name"`
+	Usage    ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+	Fields   []ExplorerNamedTypeFieldsGraphQLField       `json:"fields"`
 }
 
-// GetTypename returns SchemaPublishSchemaPublishSchemaPublishMissingServiceError.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishMissingServiceError) GetTypename() string {
-	return v.Typename
-}
+// GetTypename returns ExplorerNamedTypeGraphQLObjectType.Typename, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLObjectType) GetTypename() string { return v.Typename }
 
-// GetMessage returns SchemaPublishSchemaPublishSchemaPublishMissingServiceError.Message, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishMissingServiceError) GetMessage() string {
-	return v.Message
-}
+// GetName returns ExplorerNamedTypeGraphQLObjectType.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLObjectType) GetName() string { return v.Name }
 
-// SchemaPublishSchemaPublishSchemaPublishMissingUrlError includes the requested fields of the GraphQL type SchemaPublishMissingUrlError.
-type SchemaPublishSchemaPublishSchemaPublishMissingUrlError struct {
-	Typename string `json:"__typename"`
-	Message  string `json:"message"`
+// GetUsage returns ExplorerNamedTypeGraphQLObjectType.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLObjectType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.Usage
 }
 
-// GetTypename returns SchemaPublishSchemaPublishSchemaPublishMissingUrlError.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishMissingUrlError) GetTypename() string {
-	return v.Typename
+// GetFields returns ExplorerNamedTypeGraphQLObjectType.Fields, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLObjectType) GetFields() []ExplorerNamedTypeFieldsGraphQLField {
+	return v.Fields
 }
 
-// GetMessage returns SchemaPublishSchemaPublishSchemaPublishMissingUrlError.Message, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishMissingUrlError) GetMessage() string {
-	return v.Message
+// ExplorerNamedType includes the GraphQL fields of GraphQLScalarType requested by the fragment ExplorerNamedType.
+type ExplorerNamedTypeGraphQLScalarType struct {
+	Typename string                                      `json:"__typename"`
+	Name     string                                      `json:"name"`
+	Usage    ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
 }
 
-// SchemaPublishSchemaPublishSchemaPublishPayload includes the requested fields of the GraphQL interface SchemaPublishPayload.
-//
-// SchemaPublishSchemaPublishSchemaPublishPayload is implemented by the following types:
-// SchemaPublishSchemaPublishGitHubSchemaPublishError
-// SchemaPublishSchemaPublishGitHubSchemaPublishSuccess
-// SchemaPublishSchemaPublishSchemaPublishError
-// SchemaPublishSchemaPublishSchemaPublishMissingServiceError
-// SchemaPublishSchemaPublishSchemaPublishMissingUrlError
-// SchemaPublishSchemaPublishSchemaPublishRetry
-// SchemaPublishSchemaPublishSchemaPublishSuccess
-type SchemaPublishSchemaPublishSchemaPublishPayload interface {
-	implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() string
-}
+// GetTypename returns ExplorerNamedTypeGraphQLScalarType.Typename, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLScalarType) GetTypename() string { return v.Typename }
 
-func (v *SchemaPublishSchemaPublishGitHubSchemaPublishError) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
-}
-func (v *SchemaPublishSchemaPublishGitHubSchemaPublishSuccess) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+// GetName returns ExplorerNamedTypeGraphQLScalarType.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLScalarType) GetName() string { return v.Name }
+
+// GetUsage returns ExplorerNamedTypeGraphQLScalarType.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLScalarType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.Usage
 }
-func (v *SchemaPublishSchemaPublishSchemaPublishError) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+
+// ExplorerNamedType includes the GraphQL fields of GraphQLUnionType requested by the fragment ExplorerNamedType.
+type ExplorerNamedTypeGraphQLUnionType struct {
+	Typename string                                      `json:"__typename"`
+	Name     string                                      `json:"name"`
+	Usage    ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
 }
-func (v *SchemaPublishSchemaPublishSchemaPublishMissingServiceError) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+
+// GetTypename returns ExplorerNamedTypeGraphQLUnionType.Typename, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLUnionType) GetTypename() string { return v.Typename }
+
+// GetName returns ExplorerNamedTypeGraphQLUnionType.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLUnionType) GetName() string { return v.Name }
+
+// GetUsage returns ExplorerNamedTypeGraphQLUnionType.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeGraphQLUnionType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.Usage
 }
-func (v *SchemaPublishSchemaPublishSchemaPublishMissingUrlError) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+
+// ExplorerNamedTypeUsageSchemaCoordinateUsage includes the requested fields of the GraphQL type SchemaCoordinateUsage.
+type ExplorerNamedTypeUsageSchemaCoordinateUsage struct {
+	ExplorerUsage `json:"-"`
 }
-func (v *SchemaPublishSchemaPublishSchemaPublishRetry) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+
+// GetIsUsed returns ExplorerNamedTypeUsageSchemaCoordinateUsage.IsUsed, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeUsageSchemaCoordinateUsage) GetIsUsed() bool { return v.ExplorerUsage.IsUsed }
+
+// GetTotal returns ExplorerNamedTypeUsageSchemaCoordinateUsage.Total, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeUsageSchemaCoordinateUsage) GetTotal() float64 {
+	return v.ExplorerUsage.Total
 }
-func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+
+// GetUsedByClients returns ExplorerNamedTypeUsageSchemaCoordinateUsage.UsedByClients, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeUsageSchemaCoordinateUsage) GetUsedByClients() []string {
+	return v.ExplorerUsage.UsedByClients
 }
 
-func __unmarshalSchemaPublishSchemaPublishSchemaPublishPayload(b []byte, v *SchemaPublishSchemaPublishSchemaPublishPayload) error {
+func (v *ExplorerNamedTypeUsageSchemaCoordinateUsage) UnmarshalJSON(b []byte) error {
+
 	if string(b) == "null" {
 		return nil
 	}
 
-	var tn struct {
-		TypeName string `json:"__typename"`
+	var firstPass struct {
+		*ExplorerNamedTypeUsageSchemaCoordinateUsage
+		graphql.NoUnmarshalJSON
 	}
-	err := json.Unmarshal(b, &tn)
+	firstPass.ExplorerNamedTypeUsageSchemaCoordinateUsage = v
+
+	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
 		return err
 	}
 
-	switch tn.TypeName {
-	case "GitHubSchemaPublishError":
-		*v = new(SchemaPublishSchemaPublishGitHubSchemaPublishError)
-		return json.Unmarshal(b, *v)
-	case "GitHubSchemaPublishSuccess":
-		*v = new(SchemaPublishSchemaPublishGitHubSchemaPublishSuccess)
-		return json.Unmarshal(b, *v)
-	case "SchemaPublishError":
-		*v = new(SchemaPublishSchemaPublishSchemaPublishError)
-		return json.Unmarshal(b, *v)
-	case "SchemaPublishMissingServiceError":
-		*v = new(SchemaPublishSchemaPublishSchemaPublishMissingServiceError)
-		return json.Unmarshal(b, *v)
-	case "SchemaPublishMissingUrlError":
-		*v = new(SchemaPublishSchemaPublishSchemaPublishMissingUrlError)
-		return json.Unmarshal(b, *v)
-	case "SchemaPublishRetry":
-		*v = new(SchemaPublishSchemaPublishSchemaPublishRetry)
-		return json.Unmarshal(b, *v)
-	case "SchemaPublishSuccess":
-		*v = new(SchemaPublishSchemaPublishSchemaPublishSuccess)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing SchemaPublishPayload.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for SchemaPublishSchemaPublishSchemaPublishPayload: "%v"`, tn.TypeName)
+	err = json.Unmarshal(
+		b, &v.ExplorerUsage)
+	if err != nil {
+		return err
 	}
+	return nil
 }
 
-func __marshalSchemaPublishSchemaPublishSchemaPublishPayload(v *SchemaPublishSchemaPublishSchemaPublishPayload) ([]byte, error) {
+type __premarshalExplorerNamedTypeUsageSchemaCoordinateUsage struct {
+	IsUsed bool `json:"isUsed"`
 
-	var typename string
-	switch v := (*v).(type) {
-	case *SchemaPublishSchemaPublishGitHubSchemaPublishError:
-		typename = "GitHubSchemaPublishError"
+	Total float64 `json:"total"`
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaPublishSchemaPublishGitHubSchemaPublishError
-		}{typename, v}
-		return json.Marshal(result)
-	case *SchemaPublishSchemaPublishGitHubSchemaPublishSuccess:
-		typename = "GitHubSchemaPublishSuccess"
+	UsedByClients []string `json:"usedByClients"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaPublishSchemaPublishGitHubSchemaPublishSuccess
-		}{typename, v}
-		return json.Marshal(result)
-	case *SchemaPublishSchemaPublishSchemaPublishError:
-		typename = "SchemaPublishError"
+func (v *ExplorerNamedTypeUsageSchemaCoordinateUsage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaPublishSchemaPublishSchemaPublishError
-		}{typename, v}
-		return json.Marshal(result)
-	case *SchemaPublishSchemaPublishSchemaPublishMissingServiceError:
-		typename = "SchemaPublishMissingServiceError"
+func (v *ExplorerNamedTypeUsageSchemaCoordinateUsage) __premarshalJSON() (*__premarshalExplorerNamedTypeUsageSchemaCoordinateUsage, error) {
+	var retval __premarshalExplorerNamedTypeUsageSchemaCoordinateUsage
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaPublishSchemaPublishSchemaPublishMissingServiceError
-		}{typename, v}
-		return json.Marshal(result)
-	case *SchemaPublishSchemaPublishSchemaPublishMissingUrlError:
-		typename = "SchemaPublishMissingUrlError"
+	retval.IsUsed = v.ExplorerUsage.IsUsed
+	retval.Total = v.ExplorerUsage.Total
+	retval.UsedByClients = v.ExplorerUsage.UsedByClients
+	return &retval, nil
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaPublishSchemaPublishSchemaPublishMissingUrlError
-		}{typename, v}
-		return json.Marshal(result)
-	case *SchemaPublishSchemaPublishSchemaPublishRetry:
-		typename = "SchemaPublishRetry"
+// ExplorerNamedTypeValuesGraphQLEnumValue includes the requested fields of the GraphQL type GraphQLEnumValue.
+type ExplorerNamedTypeValuesGraphQLEnumValue struct {
+	Name              string                                                            `json:"name"`
+	IsDeprecated      bool                                                              `json:"isDeprecated"`
+	DeprecationReason *string                                                           `json:"deprecationReason"`
+	Usage             ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage `json:"usage"`
+}
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaPublishSchemaPublishSchemaPublishRetry
-		}{typename, v}
-		return json.Marshal(result)
-	case *SchemaPublishSchemaPublishSchemaPublishSuccess:
-		typename = "SchemaPublishSuccess"
+// GetName returns ExplorerNamedTypeValuesGraphQLEnumValue.Name, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeValuesGraphQLEnumValue) GetName() string { return v.Name }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*SchemaPublishSchemaPublishSchemaPublishSuccess
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for SchemaPublishSchemaPublishSchemaPublishPayload: "%T"`, v)
+// GetIsDeprecated returns ExplorerNamedTypeValuesGraphQLEnumValue.IsDeprecated, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeValuesGraphQLEnumValue) GetIsDeprecated() bool { return v.IsDeprecated }
+
+// GetDeprecationReason returns ExplorerNamedTypeValuesGraphQLEnumValue.DeprecationReason, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeValuesGraphQLEnumValue) GetDeprecationReason() *string {
+	return v.DeprecationReason
+}
+
+// GetUsage returns ExplorerNamedTypeValuesGraphQLEnumValue.Usage, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeValuesGraphQLEnumValue) GetUsage() ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage {
+	return v.Usage
+}
+
+// ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage includes the requested fields of the GraphQL type SchemaCoordinateUsage.
+type ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage struct {
+	ExplorerUsage `json:"-"`
+}
+
+// GetIsUsed returns ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage.IsUsed, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage) GetIsUsed() bool {
+	return v.ExplorerUsage.IsUsed
+}
+
+// GetTotal returns ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage.Total, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage) GetTotal() float64 {
+	return v.ExplorerUsage.Total
+}
+
+// GetUsedByClients returns ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage.UsedByClients, and is useful for accessing the field via an interface.
+func (v *ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage) GetUsedByClients() []string {
+	return v.ExplorerUsage.UsedByClients
+}
+
+func (v *ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerUsage)
+	if err != nil {
+		return err
 	}
+	return nil
 }
 
-// SchemaPublishSchemaPublishSchemaPublishRetry includes the requested fields of the GraphQL type SchemaPublishRetry.
-type SchemaPublishSchemaPublishSchemaPublishRetry struct {
-	Typename string `json:"__typename"`
-	Reason   string `json:"reason"`
+type __premarshalExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage struct {
+	IsUsed bool `json:"isUsed"`
+
+	Total float64 `json:"total"`
+
+	UsedByClients []string `json:"usedByClients"`
 }
 
-// GetTypename returns SchemaPublishSchemaPublishSchemaPublishRetry.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishRetry) GetTypename() string { return v.Typename }
+func (v *ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
 
-// GetReason returns SchemaPublishSchemaPublishSchemaPublishRetry.Reason, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishRetry) GetReason() string { return v.Reason }
+func (v *ExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage) __premarshalJSON() (*__premarshalExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage, error) {
+	var retval __premarshalExplorerNamedTypeValuesGraphQLEnumValueUsageSchemaCoordinateUsage
 
-// SchemaPublishSchemaPublishSchemaPublishSuccess includes the requested fields of the GraphQL type SchemaPublishSuccess.
-type SchemaPublishSchemaPublishSchemaPublishSuccess struct {
-	Typename       string `json:"__typename"`
-	Initial        bool   `json:"initial"`
-	Valid          bool   `json:"valid"`
-	SuccessMessage string `json:"successMessage"`
-	LinkToWebsite  string `json:"linkToWebsite"`
+	retval.IsUsed = v.ExplorerUsage.IsUsed
+	retval.Total = v.ExplorerUsage.Total
+	retval.UsedByClients = v.ExplorerUsage.UsedByClients
+	return &retval, nil
 }
 
-// GetTypename returns SchemaPublishSchemaPublishSchemaPublishSuccess.Typename, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetTypename() string { return v.Typename }
+// ExplorerUsage includes the GraphQL fields of SchemaCoordinateUsage requested by the fragment ExplorerUsage.
+type ExplorerUsage struct {
+	IsUsed bool    `json:"isUsed"`
+	Total  float64 `json:"total"`
+	// A list of clients that use this schema coordinate within GraphQL operation documents.
+	// Is null if used by none clients.
+	UsedByClients []string `json:"usedByClients"`
+}
 
-// GetInitial returns SchemaPublishSchemaPublishSchemaPublishSuccess.Initial, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetInitial() bool { return v.Initial }
+// GetIsUsed returns ExplorerUsage.IsUsed, and is useful for accessing the field via an interface.
+func (v *ExplorerUsage) GetIsUsed() bool { return v.IsUsed }
 
-// GetValid returns SchemaPublishSchemaPublishSchemaPublishSuccess.Valid, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetValid() bool { return v.Valid }
+// GetTotal returns ExplorerUsage.Total, and is useful for accessing the field via an interface.
+func (v *ExplorerUsage) GetTotal() float64 { return v.Total }
 
-// GetSuccessMessage returns SchemaPublishSchemaPublishSchemaPublishSuccess.SuccessMessage, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetSuccessMessage() string {
-	return v.SuccessMessage
+// GetUsedByClients returns ExplorerUsage.UsedByClients, and is useful for accessing the field via an interface.
+func (v *ExplorerUsage) GetUsedByClients() []string { return v.UsedByClients }
+
+type FieldListStatsInput struct {
+	Fields           []FieldTypePairInput `json:"fields"`
+	OperationHash    *string              `json:"operationHash,omitempty"`
+	OrganizationSlug string               `json:"organizationSlug"`
+	Period           DateRangeInput       `json:"period"`
+	ProjectSlug      string               `json:"projectSlug"`
+	TargetSlug       string               `json:"targetSlug"`
 }
 
-// GetLinkToWebsite returns SchemaPublishSchemaPublishSchemaPublishSuccess.LinkToWebsite, and is useful for accessing the field via an interface.
-func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetLinkToWebsite() string {
-	return v.LinkToWebsite
+// GetFields returns FieldListStatsInput.Fields, and is useful for accessing the field via an interface.
+func (v *FieldListStatsInput) GetFields() []FieldTypePairInput { return v.Fields }
+
+// GetOperationHash returns FieldListStatsInput.OperationHash, and is useful for accessing the field via an interface.
+func (v *FieldListStatsInput) GetOperationHash() *string { return v.OperationHash }
+
+// GetOrganizationSlug returns FieldListStatsInput.OrganizationSlug, and is useful for accessing the field via an interface.
+func (v *FieldListStatsInput) GetOrganizationSlug() string { return v.OrganizationSlug }
+
+// GetPeriod returns FieldListStatsInput.Period, and is useful for accessing the field via an interface.
+func (v *FieldListStatsInput) GetPeriod() DateRangeInput { return v.Period }
+
+// GetProjectSlug returns FieldListStatsInput.ProjectSlug, and is useful for accessing the field via an interface.
+func (v *FieldListStatsInput) GetProjectSlug() string { return v.ProjectSlug }
+
+// GetTargetSlug returns FieldListStatsInput.TargetSlug, and is useful for accessing the field via an interface.
+func (v *FieldListStatsInput) GetTargetSlug() string { return v.TargetSlug }
+
+type FieldTypePairInput struct {
+	Argument *string `json:"argument,omitempty"`
+	Field    string  `json:"field"`
+	Type     string  `json:"type"`
 }
 
-// Reference to a target.
-type TargetReferenceInput struct {
-	BySelector TargetSelectorInput `json:"bySelector"`
+// GetArgument returns FieldTypePairInput.Argument, and is useful for accessing the field via an interface.
+func (v *FieldTypePairInput) GetArgument() *string { return v.Argument }
+
+// GetField returns FieldTypePairInput.Field, and is useful for accessing the field via an interface.
+func (v *FieldTypePairInput) GetField() string { return v.Field }
+
+// GetType returns FieldTypePairInput.Type, and is useful for accessing the field via an interface.
+func (v *FieldTypePairInput) GetType() string { return v.Type }
+
+// GetOrganizationOrganizationOrganizationPayload includes the requested fields of the GraphQL type OrganizationPayload.
+type GetOrganizationOrganizationOrganizationPayload struct {
+	Organization GetOrganizationOrganizationOrganizationPayloadOrganization `json:"organization"`
 }
 
-// GetBySelector returns TargetReferenceInput.BySelector, and is useful for accessing the field via an interface.
-func (v *TargetReferenceInput) GetBySelector() TargetSelectorInput { return v.BySelector }
+// GetOrganization returns GetOrganizationOrganizationOrganizationPayload.Organization, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganizationOrganizationPayload) GetOrganization() GetOrganizationOrganizationOrganizationPayloadOrganization {
+	return v.Organization
+}
 
-type TargetSelectorInput struct {
-	OrganizationSlug string `json:"organizationSlug"`
-	ProjectSlug      string `json:"projectSlug"`
-	TargetSlug       string `json:"targetSlug"`
+// GetOrganizationOrganizationOrganizationPayloadOrganization includes the requested fields of the GraphQL type Organization.
+type GetOrganizationOrganizationOrganizationPayloadOrganization struct {
+	OrganizationDetails `json:"-"`
 }
 
-// GetOrganizationSlug returns TargetSelectorInput.OrganizationSlug, and is useful for accessing the field via an interface.
-func (v *TargetSelectorInput) GetOrganizationSlug() string { return v.OrganizationSlug }
+// GetId returns GetOrganizationOrganizationOrganizationPayloadOrganization.Id, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganizationOrganizationPayloadOrganization) GetId() string {
+	return v.OrganizationDetails.Id
+}
 
-// GetProjectSlug returns TargetSelectorInput.ProjectSlug, and is useful for accessing the field via an interface.
-func (v *TargetSelectorInput) GetProjectSlug() string { return v.ProjectSlug }
+// GetSlug returns GetOrganizationOrganizationOrganizationPayloadOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganizationOrganizationPayloadOrganization) GetSlug() string {
+	return v.OrganizationDetails.Slug
+}
 
-// GetTargetSlug returns TargetSelectorInput.TargetSlug, and is useful for accessing the field via an interface.
-func (v *TargetSelectorInput) GetTargetSlug() string { return v.TargetSlug }
+// GetPlan returns GetOrganizationOrganizationOrganizationPayloadOrganization.Plan, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganizationOrganizationPayloadOrganization) GetPlan() BillingPlanType {
+	return v.OrganizationDetails.Plan
+}
 
-// __ActivateAppDeploymentInput is used internally by genqlient
-type __ActivateAppDeploymentInput struct {
-	Input ActivateAppDeploymentInput `json:"input"`
+// GetRateLimit returns GetOrganizationOrganizationOrganizationPayloadOrganization.RateLimit, and is useful for accessing the field via an interface.
+func (v *GetOrganizationOrganizationOrganizationPayloadOrganization) GetRateLimit() OrganizationDetailsRateLimit {
+	return v.OrganizationDetails.RateLimit
 }
 
-// GetInput returns __ActivateAppDeploymentInput.Input, and is useful for accessing the field via an interface.
-func (v *__ActivateAppDeploymentInput) GetInput() ActivateAppDeploymentInput { return v.Input }
+func (v *GetOrganizationOrganizationOrganizationPayloadOrganization) UnmarshalJSON(b []byte) error {
 
-// __AddDocumentsToAppDeploymentInput is used internally by genqlient
-type __AddDocumentsToAppDeploymentInput struct {
-	Input AddDocumentsToAppDeploymentInput `json:"input"`
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetOrganizationOrganizationOrganizationPayloadOrganization
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetOrganizationOrganizationOrganizationPayloadOrganization = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetOrganizationOrganizationOrganizationPayloadOrganization struct {
+	Id string `json:"id"`
+
+	Slug string `json:"slug"`
+
+	Plan BillingPlanType `json:"plan"`
+
+	RateLimit OrganizationDetailsRateLimit `json:"rateLimit"`
+}
+
+func (v *GetOrganizationOrganizationOrganizationPayloadOrganization) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetOrganizationOrganizationOrganizationPayloadOrganization) __premarshalJSON() (*__premarshalGetOrganizationOrganizationOrganizationPayloadOrganization, error) {
+	var retval __premarshalGetOrganizationOrganizationOrganizationPayloadOrganization
+
+	retval.Id = v.OrganizationDetails.Id
+	retval.Slug = v.OrganizationDetails.Slug
+	retval.Plan = v.OrganizationDetails.Plan
+	retval.RateLimit = v.OrganizationDetails.RateLimit
+	return &retval, nil
+}
+
+// GetOrganizationResponse is returned by GetOrganization on success.
+type GetOrganizationResponse struct {
+	Organization *GetOrganizationOrganizationOrganizationPayload `json:"organization"`
+}
+
+// GetOrganization returns GetOrganizationResponse.Organization, and is useful for accessing the field via an interface.
+func (v *GetOrganizationResponse) GetOrganization() *GetOrganizationOrganizationOrganizationPayload {
+	return v.Organization
+}
+
+// GetProjectProject includes the requested fields of the GraphQL type Project.
+type GetProjectProject struct {
+	ProjectDetails `json:"-"`
+}
+
+// GetId returns GetProjectProject.Id, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetId() string { return v.ProjectDetails.Id }
+
+// GetSlug returns GetProjectProject.Slug, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetSlug() string { return v.ProjectDetails.Slug }
+
+// GetType returns GetProjectProject.Type, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetType() ProjectType { return v.ProjectDetails.Type }
+
+// GetBuildUrl returns GetProjectProject.BuildUrl, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetBuildUrl() *string { return v.ProjectDetails.BuildUrl }
+
+// GetValidationUrl returns GetProjectProject.ValidationUrl, and is useful for accessing the field via an interface.
+func (v *GetProjectProject) GetValidationUrl() *string { return v.ProjectDetails.ValidationUrl }
+
+func (v *GetProjectProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetProjectProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetProjectProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetProjectProject struct {
+	Id string `json:"id"`
+
+	Slug string `json:"slug"`
+
+	Type ProjectType `json:"type"`
+
+	BuildUrl *string `json:"buildUrl"`
+
+	ValidationUrl *string `json:"validationUrl"`
+}
+
+func (v *GetProjectProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetProjectProject) __premarshalJSON() (*__premarshalGetProjectProject, error) {
+	var retval __premarshalGetProjectProject
+
+	retval.Id = v.ProjectDetails.Id
+	retval.Slug = v.ProjectDetails.Slug
+	retval.Type = v.ProjectDetails.Type
+	retval.BuildUrl = v.ProjectDetails.BuildUrl
+	retval.ValidationUrl = v.ProjectDetails.ValidationUrl
+	return &retval, nil
+}
+
+// GetProjectResponse is returned by GetProject on success.
+type GetProjectResponse struct {
+	Project *GetProjectProject `json:"project"`
+}
+
+// GetProject returns GetProjectResponse.Project, and is useful for accessing the field via an interface.
+func (v *GetProjectResponse) GetProject() *GetProjectProject { return v.Project }
+
+// GetTargetResponse is returned by GetTarget on success.
+type GetTargetResponse struct {
+	Target *GetTargetTarget `json:"target"`
+}
+
+// GetTarget returns GetTargetResponse.Target, and is useful for accessing the field via an interface.
+func (v *GetTargetResponse) GetTarget() *GetTargetTarget { return v.Target }
+
+// GetTargetTarget includes the requested fields of the GraphQL type Target.
+type GetTargetTarget struct {
+	TargetDetails `json:"-"`
+}
+
+// GetId returns GetTargetTarget.Id, and is useful for accessing the field via an interface.
+func (v *GetTargetTarget) GetId() string { return v.TargetDetails.Id }
+
+// GetSlug returns GetTargetTarget.Slug, and is useful for accessing the field via an interface.
+func (v *GetTargetTarget) GetSlug() string { return v.TargetDetails.Slug }
+
+// GetCdnUrl returns GetTargetTarget.CdnUrl, and is useful for accessing the field via an interface.
+func (v *GetTargetTarget) GetCdnUrl() string { return v.TargetDetails.CdnUrl }
+
+// GetGraphqlEndpointUrl returns GetTargetTarget.GraphqlEndpointUrl, and is useful for accessing the field via an interface.
+func (v *GetTargetTarget) GetGraphqlEndpointUrl() *string { return v.TargetDetails.GraphqlEndpointUrl }
+
+// GetValidationSettings returns GetTargetTarget.ValidationSettings, and is useful for accessing the field via an interface.
+func (v *GetTargetTarget) GetValidationSettings() TargetDetailsValidationSettingsTargetValidationSettings {
+	return v.TargetDetails.ValidationSettings
+}
+
+func (v *GetTargetTarget) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*GetTargetTarget
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.GetTargetTarget = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TargetDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalGetTargetTarget struct {
+	Id string `json:"id"`
+
+	Slug string `json:"slug"`
+
+	CdnUrl string `json:"cdnUrl"`
+
+	GraphqlEndpointUrl *string `json:"graphqlEndpointUrl"`
+
+	ValidationSettings TargetDetailsValidationSettingsTargetValidationSettings `json:"validationSettings"`
+}
+
+func (v *GetTargetTarget) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *GetTargetTarget) __premarshalJSON() (*__premarshalGetTargetTarget, error) {
+	var retval __premarshalGetTargetTarget
+
+	retval.Id = v.TargetDetails.Id
+	retval.Slug = v.TargetDetails.Slug
+	retval.CdnUrl = v.TargetDetails.CdnUrl
+	retval.GraphqlEndpointUrl = v.TargetDetails.GraphqlEndpointUrl
+	retval.ValidationSettings = v.TargetDetails.ValidationSettings
+	return &retval, nil
+}
+
+type GitHubSchemaCheckInput struct {
+	Commit string `json:"commit"`
+	// The pull request number of the schema check.
+	PullRequestNumber string `json:"pullRequestNumber"`
+	// The repository name of the schema check.
+	Repository string `json:"repository"`
+}
+
+// GetCommit returns GitHubSchemaCheckInput.Commit, and is useful for accessing the field via an interface.
+func (v *GitHubSchemaCheckInput) GetCommit() string { return v.Commit }
+
+// GetPullRequestNumber returns GitHubSchemaCheckInput.PullRequestNumber, and is useful for accessing the field via an interface.
+func (v *GitHubSchemaCheckInput) GetPullRequestNumber() string { return v.PullRequestNumber }
+
+// GetRepository returns GitHubSchemaCheckInput.Repository, and is useful for accessing the field via an interface.
+func (v *GitHubSchemaCheckInput) GetRepository() string { return v.Repository }
+
+// LatestSchemaVersionResponse is returned by LatestSchemaVersion on success.
+type LatestSchemaVersionResponse struct {
+	Target *LatestSchemaVersionTarget `json:"target"`
+}
+
+// GetTarget returns LatestSchemaVersionResponse.Target, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionResponse) GetTarget() *LatestSchemaVersionTarget { return v.Target }
+
+// LatestSchemaVersionTarget includes the requested fields of the GraphQL type Target.
+type LatestSchemaVersionTarget struct {
+	Id                  string                                        `json:"id"`
+	LatestSchemaVersion *LatestSchemaVersionTargetLatestSchemaVersion `json:"latestSchemaVersion"`
+	// The latest valid (composable) schema version.
+	LatestValidSchemaVersion *LatestSchemaVersionTargetLatestValidSchemaVersion `json:"latestValidSchemaVersion"`
+}
+
+// GetId returns LatestSchemaVersionTarget.Id, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTarget) GetId() string { return v.Id }
+
+// GetLatestSchemaVersion returns LatestSchemaVersionTarget.LatestSchemaVersion, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTarget) GetLatestSchemaVersion() *LatestSchemaVersionTargetLatestSchemaVersion {
+	return v.LatestSchemaVersion
+}
+
+// GetLatestValidSchemaVersion returns LatestSchemaVersionTarget.LatestValidSchemaVersion, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTarget) GetLatestValidSchemaVersion() *LatestSchemaVersionTargetLatestValidSchemaVersion {
+	return v.LatestValidSchemaVersion
+}
+
+// LatestSchemaVersionTargetLatestSchemaVersion includes the requested fields of the GraphQL type SchemaVersion.
+type LatestSchemaVersionTargetLatestSchemaVersion struct {
+	SchemaVersionDetails `json:"-"`
+}
+
+// GetId returns LatestSchemaVersionTargetLatestSchemaVersion.Id, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) GetId() string {
+	return v.SchemaVersionDetails.Id
+}
+
+// GetDate returns LatestSchemaVersionTargetLatestSchemaVersion.Date, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) GetDate() time.Time {
+	return v.SchemaVersionDetails.Date
+}
+
+// GetValid returns LatestSchemaVersionTargetLatestSchemaVersion.Valid, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) GetValid() bool {
+	return v.SchemaVersionDetails.Valid
+}
+
+// GetIsComposable returns LatestSchemaVersionTargetLatestSchemaVersion.IsComposable, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) GetIsComposable() bool {
+	return v.SchemaVersionDetails.IsComposable
+}
+
+// GetSdl returns LatestSchemaVersionTargetLatestSchemaVersion.Sdl, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) GetSdl() *string {
+	return v.SchemaVersionDetails.Sdl
+}
+
+// GetSupergraph returns LatestSchemaVersionTargetLatestSchemaVersion.Supergraph, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) GetSupergraph() *string {
+	return v.SchemaVersionDetails.Supergraph
+}
+
+// GetTags returns LatestSchemaVersionTargetLatestSchemaVersion.Tags, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) GetTags() []string {
+	return v.SchemaVersionDetails.Tags
+}
+
+// GetSchemas returns LatestSchemaVersionTargetLatestSchemaVersion.Schemas, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) GetSchemas() SchemaVersionDetailsSchemasSchemaConnection {
+	return v.SchemaVersionDetails.Schemas
+}
+
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*LatestSchemaVersionTargetLatestSchemaVersion
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.LatestSchemaVersionTargetLatestSchemaVersion = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SchemaVersionDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalLatestSchemaVersionTargetLatestSchemaVersion struct {
+	Id string `json:"id"`
+
+	Date time.Time `json:"date"`
+
+	Valid bool `json:"valid"`
+
+	IsComposable bool `json:"isComposable"`
+
+	Sdl *string `json:"sdl"`
+
+	Supergraph *string `json:"supergraph"`
+
+	Tags []string `json:"tags"`
+
+	Schemas SchemaVersionDetailsSchemasSchemaConnection `json:"schemas"`
+}
+
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *LatestSchemaVersionTargetLatestSchemaVersion) __premarshalJSON() (*__premarshalLatestSchemaVersionTargetLatestSchemaVersion, error) {
+	var retval __premarshalLatestSchemaVersionTargetLatestSchemaVersion
+
+	retval.Id = v.SchemaVersionDetails.Id
+	retval.Date = v.SchemaVersionDetails.Date
+	retval.Valid = v.SchemaVersionDetails.Valid
+	retval.IsComposable = v.SchemaVersionDetails.IsComposable
+	retval.Sdl = v.SchemaVersionDetails.Sdl
+	retval.Supergraph = v.SchemaVersionDetails.Supergraph
+	retval.Tags = v.SchemaVersionDetails.Tags
+	retval.Schemas = v.SchemaVersionDetails.Schemas
+	return &retval, nil
+}
+
+// LatestSchemaVersionTargetLatestValidSchemaVersion includes the requested fields of the GraphQL type SchemaVersion.
+type LatestSchemaVersionTargetLatestValidSchemaVersion struct {
+	SchemaVersionDetails `json:"-"`
+}
+
+// GetId returns LatestSchemaVersionTargetLatestValidSchemaVersion.Id, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) GetId() string {
+	return v.SchemaVersionDetails.Id
+}
+
+// GetDate returns LatestSchemaVersionTargetLatestValidSchemaVersion.Date, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) GetDate() time.Time {
+	return v.SchemaVersionDetails.Date
+}
+
+// GetValid returns LatestSchemaVersionTargetLatestValidSchemaVersion.Valid, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) GetValid() bool {
+	return v.SchemaVersionDetails.Valid
+}
+
+// GetIsComposable returns LatestSchemaVersionTargetLatestValidSchemaVersion.IsComposable, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) GetIsComposable() bool {
+	return v.SchemaVersionDetails.IsComposable
+}
+
+// GetSdl returns LatestSchemaVersionTargetLatestValidSchemaVersion.Sdl, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) GetSdl() *string {
+	return v.SchemaVersionDetails.Sdl
+}
+
+// GetSupergraph returns LatestSchemaVersionTargetLatestValidSchemaVersion.Supergraph, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) GetSupergraph() *string {
+	return v.SchemaVersionDetails.Supergraph
+}
+
+// GetTags returns LatestSchemaVersionTargetLatestValidSchemaVersion.Tags, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) GetTags() []string {
+	return v.SchemaVersionDetails.Tags
+}
+
+// GetSchemas returns LatestSchemaVersionTargetLatestValidSchemaVersion.Schemas, and is useful for accessing the field via an interface.
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) GetSchemas() SchemaVersionDetailsSchemasSchemaConnection {
+	return v.SchemaVersionDetails.Schemas
+}
+
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*LatestSchemaVersionTargetLatestValidSchemaVersion
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.LatestSchemaVersionTargetLatestValidSchemaVersion = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SchemaVersionDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalLatestSchemaVersionTargetLatestValidSchemaVersion struct {
+	Id string `json:"id"`
+
+	Date time.Time `json:"date"`
+
+	Valid bool `json:"valid"`
+
+	IsComposable bool `json:"isComposable"`
+
+	Sdl *string `json:"sdl"`
+
+	Supergraph *string `json:"supergraph"`
+
+	Tags []string `json:"tags"`
+
+	Schemas SchemaVersionDetailsSchemasSchemaConnection `json:"schemas"`
+}
+
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *LatestSchemaVersionTargetLatestValidSchemaVersion) __premarshalJSON() (*__premarshalLatestSchemaVersionTargetLatestValidSchemaVersion, error) {
+	var retval __premarshalLatestSchemaVersionTargetLatestValidSchemaVersion
+
+	retval.Id = v.SchemaVersionDetails.Id
+	retval.Date = v.SchemaVersionDetails.Date
+	retval.Valid = v.SchemaVersionDetails.Valid
+	retval.IsComposable = v.SchemaVersionDetails.IsComposable
+	retval.Sdl = v.SchemaVersionDetails.Sdl
+	retval.Supergraph = v.SchemaVersionDetails.Supergraph
+	retval.Tags = v.SchemaVersionDetails.Tags
+	retval.Schemas = v.SchemaVersionDetails.Schemas
+	return &retval, nil
+}
+
+// LatestValidSchemaLatestValidVersionSchemaVersion includes the requested fields of the GraphQL type SchemaVersion.
+type LatestValidSchemaLatestValidVersionSchemaVersion struct {
+	Id  string  `json:"id"`
+	Sdl *string `json:"sdl"`
+}
+
+// GetId returns LatestValidSchemaLatestValidVersionSchemaVersion.Id, and is useful for accessing the field via an interface.
+func (v *LatestValidSchemaLatestValidVersionSchemaVersion) GetId() string { return v.Id }
+
+// GetSdl returns LatestValidSchemaLatestValidVersionSchemaVersion.Sdl, and is useful for accessing the field via an interface.
+func (v *LatestValidSchemaLatestValidVersionSchemaVersion) GetSdl() *string { return v.Sdl }
+
+// LatestValidSchemaResponse is returned by LatestValidSchema on success.
+type LatestValidSchemaResponse struct {
+	LatestValidVersion *LatestValidSchemaLatestValidVersionSchemaVersion `json:"latestValidVersion"`
+}
+
+// GetLatestValidVersion returns LatestValidSchemaResponse.LatestValidVersion, and is useful for accessing the field via an interface.
+func (v *LatestValidSchemaResponse) GetLatestValidVersion() *LatestValidSchemaLatestValidVersionSchemaVersion {
+	return v.LatestValidVersion
+}
+
+// ListProjectsProjectsProjectConnection includes the requested fields of the GraphQL type ProjectConnection.
+type ListProjectsProjectsProjectConnection struct {
+	Nodes []ListProjectsProjectsProjectConnectionNodesProject `json:"nodes"`
+}
+
+// GetNodes returns ListProjectsProjectsProjectConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListProjectsProjectsProjectConnection) GetNodes() []ListProjectsProjectsProjectConnectionNodesProject {
+	return v.Nodes
+}
+
+// ListProjectsProjectsProjectConnectionNodesProject includes the requested fields of the GraphQL type Project.
+type ListProjectsProjectsProjectConnectionNodesProject struct {
+	ProjectDetails `json:"-"`
+}
+
+// GetId returns ListProjectsProjectsProjectConnectionNodesProject.Id, and is useful for accessing the field via an interface.
+func (v *ListProjectsProjectsProjectConnectionNodesProject) GetId() string {
+	return v.ProjectDetails.Id
+}
+
+// GetSlug returns ListProjectsProjectsProjectConnectionNodesProject.Slug, and is useful for accessing the field via an interface.
+func (v *ListProjectsProjectsProjectConnectionNodesProject) GetSlug() string {
+	return v.ProjectDetails.Slug
+}
+
+// GetType returns ListProjectsProjectsProjectConnectionNodesProject.Type, and is useful for accessing the field via an interface.
+func (v *ListProjectsProjectsProjectConnectionNodesProject) GetType() ProjectType {
+	return v.ProjectDetails.Type
+}
+
+// GetBuildUrl returns ListProjectsProjectsProjectConnectionNodesProject.BuildUrl, and is useful for accessing the field via an interface.
+func (v *ListProjectsProjectsProjectConnectionNodesProject) GetBuildUrl() *string {
+	return v.ProjectDetails.BuildUrl
+}
+
+// GetValidationUrl returns ListProjectsProjectsProjectConnectionNodesProject.ValidationUrl, and is useful for accessing the field via an interface.
+func (v *ListProjectsProjectsProjectConnectionNodesProject) GetValidationUrl() *string {
+	return v.ProjectDetails.ValidationUrl
+}
+
+func (v *ListProjectsProjectsProjectConnectionNodesProject) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListProjectsProjectsProjectConnectionNodesProject
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListProjectsProjectsProjectConnectionNodesProject = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ProjectDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListProjectsProjectsProjectConnectionNodesProject struct {
+	Id string `json:"id"`
+
+	Slug string `json:"slug"`
+
+	Type ProjectType `json:"type"`
+
+	BuildUrl *string `json:"buildUrl"`
+
+	ValidationUrl *string `json:"validationUrl"`
+}
+
+func (v *ListProjectsProjectsProjectConnectionNodesProject) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListProjectsProjectsProjectConnectionNodesProject) __premarshalJSON() (*__premarshalListProjectsProjectsProjectConnectionNodesProject, error) {
+	var retval __premarshalListProjectsProjectsProjectConnectionNodesProject
+
+	retval.Id = v.ProjectDetails.Id
+	retval.Slug = v.ProjectDetails.Slug
+	retval.Type = v.ProjectDetails.Type
+	retval.BuildUrl = v.ProjectDetails.BuildUrl
+	retval.ValidationUrl = v.ProjectDetails.ValidationUrl
+	return &retval, nil
+}
+
+// ListProjectsResponse is returned by ListProjects on success.
+type ListProjectsResponse struct {
+	Projects ListProjectsProjectsProjectConnection `json:"projects"`
+}
+
+// GetProjects returns ListProjectsResponse.Projects, and is useful for accessing the field via an interface.
+func (v *ListProjectsResponse) GetProjects() ListProjectsProjectsProjectConnection { return v.Projects }
+
+// ListTargetsResponse is returned by ListTargets on success.
+type ListTargetsResponse struct {
+	Targets ListTargetsTargetsTargetConnection `json:"targets"`
+}
+
+// GetTargets returns ListTargetsResponse.Targets, and is useful for accessing the field via an interface.
+func (v *ListTargetsResponse) GetTargets() ListTargetsTargetsTargetConnection { return v.Targets }
+
+// ListTargetsTargetsTargetConnection includes the requested fields of the GraphQL type TargetConnection.
+type ListTargetsTargetsTargetConnection struct {
+	Nodes []ListTargetsTargetsTargetConnectionNodesTarget `json:"nodes"`
+}
+
+// GetNodes returns ListTargetsTargetsTargetConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListTargetsTargetsTargetConnection) GetNodes() []ListTargetsTargetsTargetConnectionNodesTarget {
+	return v.Nodes
+}
+
+// ListTargetsTargetsTargetConnectionNodesTarget includes the requested fields of the GraphQL type Target.
+type ListTargetsTargetsTargetConnectionNodesTarget struct {
+	TargetDetails `json:"-"`
+}
+
+// GetId returns ListTargetsTargetsTargetConnectionNodesTarget.Id, and is useful for accessing the field via an interface.
+func (v *ListTargetsTargetsTargetConnectionNodesTarget) GetId() string { return v.TargetDetails.Id }
+
+// GetSlug returns ListTargetsTargetsTargetConnectionNodesTarget.Slug, and is useful for accessing the field via an interface.
+func (v *ListTargetsTargetsTargetConnectionNodesTarget) GetSlug() string { return v.TargetDetails.Slug }
+
+// GetCdnUrl returns ListTargetsTargetsTargetConnectionNodesTarget.CdnUrl, and is useful for accessing the field via an interface.
+func (v *ListTargetsTargetsTargetConnectionNodesTarget) GetCdnUrl() string {
+	return v.TargetDetails.CdnUrl
+}
+
+// GetGraphqlEndpointUrl returns ListTargetsTargetsTargetConnectionNodesTarget.GraphqlEndpointUrl, and is useful for accessing the field via an interface.
+func (v *ListTargetsTargetsTargetConnectionNodesTarget) GetGraphqlEndpointUrl() *string {
+	return v.TargetDetails.GraphqlEndpointUrl
+}
+
+// GetValidationSettings returns ListTargetsTargetsTargetConnectionNodesTarget.ValidationSettings, and is useful for accessing the field via an interface.
+func (v *ListTargetsTargetsTargetConnectionNodesTarget) GetValidationSettings() TargetDetailsValidationSettingsTargetValidationSettings {
+	return v.TargetDetails.ValidationSettings
+}
+
+func (v *ListTargetsTargetsTargetConnectionNodesTarget) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListTargetsTargetsTargetConnectionNodesTarget
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListTargetsTargetsTargetConnectionNodesTarget = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.TargetDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListTargetsTargetsTargetConnectionNodesTarget struct {
+	Id string `json:"id"`
+
+	Slug string `json:"slug"`
+
+	CdnUrl string `json:"cdnUrl"`
+
+	GraphqlEndpointUrl *string `json:"graphqlEndpointUrl"`
+
+	ValidationSettings TargetDetailsValidationSettingsTargetValidationSettings `json:"validationSettings"`
+}
+
+func (v *ListTargetsTargetsTargetConnectionNodesTarget) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListTargetsTargetsTargetConnectionNodesTarget) __premarshalJSON() (*__premarshalListTargetsTargetsTargetConnectionNodesTarget, error) {
+	var retval __premarshalListTargetsTargetsTargetConnectionNodesTarget
+
+	retval.Id = v.TargetDetails.Id
+	retval.Slug = v.TargetDetails.Slug
+	retval.CdnUrl = v.TargetDetails.CdnUrl
+	retval.GraphqlEndpointUrl = v.TargetDetails.GraphqlEndpointUrl
+	retval.ValidationSettings = v.TargetDetails.ValidationSettings
+	return &retval, nil
+}
+
+// OperationStatsDetails includes the GraphQL fields of OperationStatsValues requested by the fragment OperationStatsDetails.
+type OperationStatsDetails struct {
+	Id            string  `json:"id"`
+	Name          string  `json:"name"`
+	Kind          string  `json:"kind"`
+	OperationHash *string `json:"operationHash"`
+	// Total number of requests
+	Count int64 `json:"count"`
+	// Number of requests that succeeded
+	CountOk    int64                                       `json:"countOk"`
+	Percentage float64                                     `json:"percentage"`
+	Duration   OperationStatsDetailsDurationDurationValues `json:"duration"`
+}
+
+// GetId returns OperationStatsDetails.Id, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetails) GetId() string { return v.Id }
+
+// GetName returns OperationStatsDetails.Name, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetails) GetName() string { return v.Name }
+
+// GetKind returns OperationStatsDetails.Kind, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetails) GetKind() string { return v.Kind }
+
+// GetOperationHash returns OperationStatsDetails.OperationHash, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetails) GetOperationHash() *string { return v.OperationHash }
+
+// GetCount returns OperationStatsDetails.Count, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetails) GetCount() int64 { return v.Count }
+
+// GetCountOk returns OperationStatsDetails.CountOk, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetails) GetCountOk() int64 { return v.CountOk }
+
+// GetPercentage returns OperationStatsDetails.Percentage, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetails) GetPercentage() float64 { return v.Percentage }
+
+// GetDuration returns OperationStatsDetails.Duration, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetails) GetDuration() OperationStatsDetailsDurationDurationValues {
+	return v.Duration
+}
+
+// OperationStatsDetailsDurationDurationValues includes the requested fields of the GraphQL type DurationValues.
+type OperationStatsDetailsDurationDurationValues struct {
+	DurationDetails `json:"-"`
+}
+
+// GetAvg returns OperationStatsDetailsDurationDurationValues.Avg, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetailsDurationDurationValues) GetAvg() int { return v.DurationDetails.Avg }
+
+// GetP75 returns OperationStatsDetailsDurationDurationValues.P75, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetailsDurationDurationValues) GetP75() int { return v.DurationDetails.P75 }
+
+// GetP90 returns OperationStatsDetailsDurationDurationValues.P90, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetailsDurationDurationValues) GetP90() int { return v.DurationDetails.P90 }
+
+// GetP95 returns OperationStatsDetailsDurationDurationValues.P95, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetailsDurationDurationValues) GetP95() int { return v.DurationDetails.P95 }
+
+// GetP99 returns OperationStatsDetailsDurationDurationValues.P99, and is useful for accessing the field via an interface.
+func (v *OperationStatsDetailsDurationDurationValues) GetP99() int { return v.DurationDetails.P99 }
+
+func (v *OperationStatsDetailsDurationDurationValues) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*OperationStatsDetailsDurationDurationValues
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.OperationStatsDetailsDurationDurationValues = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DurationDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalOperationStatsDetailsDurationDurationValues struct {
+	Avg int `json:"avg"`
+
+	P75 int `json:"p75"`
+
+	P90 int `json:"p90"`
+
+	P95 int `json:"p95"`
+
+	P99 int `json:"p99"`
+}
+
+func (v *OperationStatsDetailsDurationDurationValues) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *OperationStatsDetailsDurationDurationValues) __premarshalJSON() (*__premarshalOperationStatsDetailsDurationDurationValues, error) {
+	var retval __premarshalOperationStatsDetailsDurationDurationValues
+
+	retval.Avg = v.DurationDetails.Avg
+	retval.P75 = v.DurationDetails.P75
+	retval.P90 = v.DurationDetails.P90
+	retval.P95 = v.DurationDetails.P95
+	retval.P99 = v.DurationDetails.P99
+	return &retval, nil
+}
+
+// OperationsStatsOperationsStats includes the requested fields of the GraphQL type OperationsStats.
+type OperationsStatsOperationsStats struct {
+	TotalRequests   int64                                                                  `json:"totalRequests"`
+	TotalFailures   int64                                                                  `json:"totalFailures"`
+	TotalOperations int                                                                    `json:"totalOperations"`
+	Duration        OperationsStatsOperationsStatsDurationDurationValues                   `json:"duration"`
+	Operations      OperationsStatsOperationsStatsOperationsOperationStatsValuesConnection `json:"operations"`
+	Clients         OperationsStatsOperationsStatsClientsClientStatsValuesConnection       `json:"clients"`
+}
+
+// GetTotalRequests returns OperationsStatsOperationsStats.TotalRequests, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStats) GetTotalRequests() int64 { return v.TotalRequests }
+
+// GetTotalFailures returns OperationsStatsOperationsStats.TotalFailures, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStats) GetTotalFailures() int64 { return v.TotalFailures }
+
+// GetTotalOperations returns OperationsStatsOperationsStats.TotalOperations, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStats) GetTotalOperations() int { return v.TotalOperations }
+
+// GetDuration returns OperationsStatsOperationsStats.Duration, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStats) GetDuration() OperationsStatsOperationsStatsDurationDurationValues {
+	return v.Duration
+}
+
+// GetOperations returns OperationsStatsOperationsStats.Operations, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStats) GetOperations() OperationsStatsOperationsStatsOperationsOperationStatsValuesConnection {
+	return v.Operations
+}
+
+// GetClients returns OperationsStatsOperationsStats.Clients, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStats) GetClients() OperationsStatsOperationsStatsClientsClientStatsValuesConnection {
+	return v.Clients
+}
+
+// OperationsStatsOperationsStatsClientsClientStatsValuesConnection includes the requested fields of the GraphQL type ClientStatsValuesConnection.
+type OperationsStatsOperationsStatsClientsClientStatsValuesConnection struct {
+	Nodes []OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues `json:"nodes"`
+}
+
+// GetNodes returns OperationsStatsOperationsStatsClientsClientStatsValuesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsClientsClientStatsValuesConnection) GetNodes() []OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues {
+	return v.Nodes
+}
+
+// OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues includes the requested fields of the GraphQL type ClientStatsValues.
+type OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues struct {
+	ClientStatsDetails `json:"-"`
+}
+
+// GetName returns OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues.Name, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues) GetName() string {
+	return v.ClientStatsDetails.Name
+}
+
+// GetCount returns OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues.Count, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues) GetCount() float64 {
+	return v.ClientStatsDetails.Count
+}
+
+// GetPercentage returns OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues.Percentage, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues) GetPercentage() float64 {
+	return v.ClientStatsDetails.Percentage
+}
+
+// GetVersions returns OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues.Versions, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues) GetVersions() []ClientStatsDetailsVersionsClientVersionStatsValues {
+	return v.ClientStatsDetails.Versions
+}
+
+func (v *OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ClientStatsDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalOperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues struct {
+	Name string `json:"name"`
+
+	Count float64 `json:"count"`
+
+	Percentage float64 `json:"percentage"`
+
+	Versions []ClientStatsDetailsVersionsClientVersionStatsValues `json:"versions"`
+}
+
+func (v *OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *OperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues) __premarshalJSON() (*__premarshalOperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues, error) {
+	var retval __premarshalOperationsStatsOperationsStatsClientsClientStatsValuesConnectionNodesClientStatsValues
+
+	retval.Name = v.ClientStatsDetails.Name
+	retval.Count = v.ClientStatsDetails.Count
+	retval.Percentage = v.ClientStatsDetails.Percentage
+	retval.Versions = v.ClientStatsDetails.Versions
+	return &retval, nil
+}
+
+// OperationsStatsOperationsStatsDurationDurationValues includes the requested fields of the GraphQL type DurationValues.
+type OperationsStatsOperationsStatsDurationDurationValues struct {
+	DurationDetails `json:"-"`
+}
+
+// GetAvg returns OperationsStatsOperationsStatsDurationDurationValues.Avg, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsDurationDurationValues) GetAvg() int {
+	return v.DurationDetails.Avg
+}
+
+// GetP75 returns OperationsStatsOperationsStatsDurationDurationValues.P75, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsDurationDurationValues) GetP75() int {
+	return v.DurationDetails.P75
+}
+
+// GetP90 returns OperationsStatsOperationsStatsDurationDurationValues.P90, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsDurationDurationValues) GetP90() int {
+	return v.DurationDetails.P90
+}
+
+// GetP95 returns OperationsStatsOperationsStatsDurationDurationValues.P95, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsDurationDurationValues) GetP95() int {
+	return v.DurationDetails.P95
+}
+
+// GetP99 returns OperationsStatsOperationsStatsDurationDurationValues.P99, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsDurationDurationValues) GetP99() int {
+	return v.DurationDetails.P99
+}
+
+func (v *OperationsStatsOperationsStatsDurationDurationValues) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*OperationsStatsOperationsStatsDurationDurationValues
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.OperationsStatsOperationsStatsDurationDurationValues = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DurationDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalOperationsStatsOperationsStatsDurationDurationValues struct {
+	Avg int `json:"avg"`
+
+	P75 int `json:"p75"`
+
+	P90 int `json:"p90"`
+
+	P95 int `json:"p95"`
+
+	P99 int `json:"p99"`
+}
+
+func (v *OperationsStatsOperationsStatsDurationDurationValues) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *OperationsStatsOperationsStatsDurationDurationValues) __premarshalJSON() (*__premarshalOperationsStatsOperationsStatsDurationDurationValues, error) {
+	var retval __premarshalOperationsStatsOperationsStatsDurationDurationValues
+
+	retval.Avg = v.DurationDetails.Avg
+	retval.P75 = v.DurationDetails.P75
+	retval.P90 = v.DurationDetails.P90
+	retval.P95 = v.DurationDetails.P95
+	retval.P99 = v.DurationDetails.P99
+	return &retval, nil
+}
+
+// OperationsStatsOperationsStatsOperationsOperationStatsValuesConnection includes the requested fields of the GraphQL type OperationStatsValuesConnection.
+type OperationsStatsOperationsStatsOperationsOperationStatsValuesConnection struct {
+	Nodes []OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues `json:"nodes"`
+}
+
+// GetNodes returns OperationsStatsOperationsStatsOperationsOperationStatsValuesConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnection) GetNodes() []OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues {
+	return v.Nodes
+}
+
+// OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues includes the requested fields of the GraphQL type OperationStatsValues.
+type OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues struct {
+	OperationStatsDetails `json:"-"`
+}
+
+// GetId returns OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Id, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetId() string {
+	return v.OperationStatsDetails.Id
+}
+
+// GetName returns OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Name, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetName() string {
+	return v.OperationStatsDetails.Name
+}
+
+// GetKind returns OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Kind, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetKind() string {
+	return v.OperationStatsDetails.Kind
+}
+
+// GetOperationHash returns OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.OperationHash, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetOperationHash() *string {
+	return v.OperationStatsDetails.OperationHash
+}
+
+// GetCount returns OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Count, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetCount() int64 {
+	return v.OperationStatsDetails.Count
+}
+
+// GetCountOk returns OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.CountOk, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetCountOk() int64 {
+	return v.OperationStatsDetails.CountOk
+}
+
+// GetPercentage returns OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Percentage, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetPercentage() float64 {
+	return v.OperationStatsDetails.Percentage
+}
+
+// GetDuration returns OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues.Duration, and is useful for accessing the field via an interface.
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) GetDuration() OperationStatsDetailsDurationDurationValues {
+	return v.OperationStatsDetails.Duration
+}
+
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OperationStatsDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalOperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues struct {
+	Id string `json:"id"`
+
+	Name string `json:"name"`
+
+	Kind string `json:"kind"`
+
+	OperationHash *string `json:"operationHash"`
+
+	Count int64 `json:"count"`
+
+	CountOk int64 `json:"countOk"`
+
+	Percentage float64 `json:"percentage"`
+
+	Duration OperationStatsDetailsDurationDurationValues `json:"duration"`
+}
+
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *OperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues) __premarshalJSON() (*__premarshalOperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues, error) {
+	var retval __premarshalOperationsStatsOperationsStatsOperationsOperationStatsValuesConnectionNodesOperationStatsValues
+
+	retval.Id = v.OperationStatsDetails.Id
+	retval.Name = v.OperationStatsDetails.Name
+	retval.Kind = v.OperationStatsDetails.Kind
+	retval.OperationHash = v.OperationStatsDetails.OperationHash
+	retval.Count = v.OperationStatsDetails.Count
+	retval.CountOk = v.OperationStatsDetails.CountOk
+	retval.Percentage = v.OperationStatsDetails.Percentage
+	retval.Duration = v.OperationStatsDetails.Duration
+	return &retval, nil
+}
+
+// OperationsStatsResponse is returned by OperationsStats on success.
+type OperationsStatsResponse struct {
+	OperationsStats OperationsStatsOperationsStats `json:"operationsStats"`
+}
+
+// GetOperationsStats returns OperationsStatsResponse.OperationsStats, and is useful for accessing the field via an interface.
+func (v *OperationsStatsResponse) GetOperationsStats() OperationsStatsOperationsStats {
+	return v.OperationsStats
+}
+
+type OperationsStatsSelectorInput struct {
+	ClientNames      []string       `json:"clientNames,omitempty"`
+	Operations       []string       `json:"operations,omitempty"`
+	OrganizationSlug string         `json:"organizationSlug"`
+	Period           DateRangeInput `json:"period"`
+	ProjectSlug      string         `json:"projectSlug"`
+	TargetSlug       string         `json:"targetSlug"`
+}
+
+// GetClientNames returns OperationsStatsSelectorInput.ClientNames, and is useful for accessing the field via an interface.
+func (v *OperationsStatsSelectorInput) GetClientNames() []string { return v.ClientNames }
+
+// GetOperations returns OperationsStatsSelectorInput.Operations, and is useful for accessing the field via an interface.
+func (v *OperationsStatsSelectorInput) GetOperations() []string { return v.Operations }
+
+// GetOrganizationSlug returns OperationsStatsSelectorInput.OrganizationSlug, and is useful for accessing the field via an interface.
+func (v *OperationsStatsSelectorInput) GetOrganizationSlug() string { return v.OrganizationSlug }
+
+// GetPeriod returns OperationsStatsSelectorInput.Period, and is useful for accessing the field via an interface.
+func (v *OperationsStatsSelectorInput) GetPeriod() DateRangeInput { return v.Period }
+
+// GetProjectSlug returns OperationsStatsSelectorInput.ProjectSlug, and is useful for accessing the field via an interface.
+func (v *OperationsStatsSelectorInput) GetProjectSlug() string { return v.ProjectSlug }
+
+// GetTargetSlug returns OperationsStatsSelectorInput.TargetSlug, and is useful for accessing the field via an interface.
+func (v *OperationsStatsSelectorInput) GetTargetSlug() string { return v.TargetSlug }
+
+// OrganizationDetails includes the GraphQL fields of Organization requested by the fragment OrganizationDetails.
+type OrganizationDetails struct {
+	Id        string                       `json:"id"`
+	Slug      string                       `json:"slug"`
+	Plan      BillingPlanType              `json:"plan"`
+	RateLimit OrganizationDetailsRateLimit `json:"rateLimit"`
+}
+
+// GetId returns OrganizationDetails.Id, and is useful for accessing the field via an interface.
+func (v *OrganizationDetails) GetId() string { return v.Id }
+
+// GetSlug returns OrganizationDetails.Slug, and is useful for accessing the field via an interface.
+func (v *OrganizationDetails) GetSlug() string { return v.Slug }
+
+// GetPlan returns OrganizationDetails.Plan, and is useful for accessing the field via an interface.
+func (v *OrganizationDetails) GetPlan() BillingPlanType { return v.Plan }
+
+// GetRateLimit returns OrganizationDetails.RateLimit, and is useful for accessing the field via an interface.
+func (v *OrganizationDetails) GetRateLimit() OrganizationDetailsRateLimit { return v.RateLimit }
+
+// OrganizationDetailsRateLimit includes the requested fields of the GraphQL type RateLimit.
+type OrganizationDetailsRateLimit struct {
+	Operations           int64 `json:"operations"`
+	RetentionInDays      int   `json:"retentionInDays"`
+	LimitedForOperations bool  `json:"limitedForOperations"`
+}
+
+// GetOperations returns OrganizationDetailsRateLimit.Operations, and is useful for accessing the field via an interface.
+func (v *OrganizationDetailsRateLimit) GetOperations() int64 { return v.Operations }
+
+// GetRetentionInDays returns OrganizationDetailsRateLimit.RetentionInDays, and is useful for accessing the field via an interface.
+func (v *OrganizationDetailsRateLimit) GetRetentionInDays() int { return v.RetentionInDays }
+
+// GetLimitedForOperations returns OrganizationDetailsRateLimit.LimitedForOperations, and is useful for accessing the field via an interface.
+func (v *OrganizationDetailsRateLimit) GetLimitedForOperations() bool { return v.LimitedForOperations }
+
+type OrganizationSelectorInput struct {
+	OrganizationSlug string `json:"organizationSlug"`
+}
+
+// GetOrganizationSlug returns OrganizationSelectorInput.OrganizationSlug, and is useful for accessing the field via an interface.
+func (v *OrganizationSelectorInput) GetOrganizationSlug() string { return v.OrganizationSlug }
+
+// ProjectDetails includes the GraphQL fields of Project requested by the fragment ProjectDetails.
+type ProjectDetails struct {
+	Id            string      `json:"id"`
+	Slug          string      `json:"slug"`
+	Type          ProjectType `json:"type"`
+	BuildUrl      *string     `json:"buildUrl"`
+	ValidationUrl *string     `json:"validationUrl"`
+}
+
+// GetId returns ProjectDetails.Id, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetId() string { return v.Id }
+
+// GetSlug returns ProjectDetails.Slug, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetSlug() string { return v.Slug }
+
+// GetType returns ProjectDetails.Type, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetType() ProjectType { return v.Type }
+
+// GetBuildUrl returns ProjectDetails.BuildUrl, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetBuildUrl() *string { return v.BuildUrl }
+
+// GetValidationUrl returns ProjectDetails.ValidationUrl, and is useful for accessing the field via an interface.
+func (v *ProjectDetails) GetValidationUrl() *string { return v.ValidationUrl }
+
+type ProjectSelectorInput struct {
+	OrganizationSlug string `json:"organizationSlug"`
+	ProjectSlug      string `json:"projectSlug"`
+}
+
+// GetOrganizationSlug returns ProjectSelectorInput.OrganizationSlug, and is useful for accessing the field via an interface.
+func (v *ProjectSelectorInput) GetOrganizationSlug() string { return v.OrganizationSlug }
+
+// GetProjectSlug returns ProjectSelectorInput.ProjectSlug, and is useful for accessing the field via an interface.
+func (v *ProjectSelectorInput) GetProjectSlug() string { return v.ProjectSlug }
+
+type ProjectType string
+
+const (
+	ProjectTypeFederation ProjectType = "FEDERATION"
+	ProjectTypeSingle     ProjectType = "SINGLE"
+	ProjectTypeStitching  ProjectType = "STITCHING"
+)
+
+var AllProjectType = []ProjectType{
+	ProjectTypeFederation,
+	ProjectTypeSingle,
+	ProjectTypeStitching,
+}
+
+type RateLimitInput struct {
+	Operations int64 `json:"operations"`
+}
+
+// GetOperations returns RateLimitInput.Operations, and is useful for accessing the field via an interface.
+func (v *RateLimitInput) GetOperations() int64 { return v.Operations }
+
+type RetireAppDeploymentInput struct {
+	AppName    string `json:"appName"`
+	AppVersion string `json:"appVersion"`
+	TargetId   string `json:"targetId"`
+}
+
+// GetAppName returns RetireAppDeploymentInput.AppName, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentInput) GetAppName() string { return v.AppName }
+
+// GetAppVersion returns RetireAppDeploymentInput.AppVersion, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentInput) GetAppVersion() string { return v.AppVersion }
+
+// GetTargetId returns RetireAppDeploymentInput.TargetId, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentInput) GetTargetId() string { return v.TargetId }
+
+// RetireAppDeploymentResponse is returned by RetireAppDeployment on success.
+type RetireAppDeploymentResponse struct {
+	RetireAppDeployment RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResult `json:"retireAppDeployment"`
+}
+
+// GetRetireAppDeployment returns RetireAppDeploymentResponse.RetireAppDeployment, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentResponse) GetRetireAppDeployment() RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResult {
+	return v.RetireAppDeployment
+}
+
+// RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResult includes the requested fields of the GraphQL type RetireAppDeploymentResult.
+type RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResult struct {
+	Ok    *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOk       `json:"ok"`
+	Error *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultErrorRetireAppDeploymentError `json:"error"`
+}
+
+// GetOk returns RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResult.Ok, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResult) GetOk() *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOk {
+	return v.Ok
+}
+
+// GetError returns RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResult.Error, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResult) GetError() *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultErrorRetireAppDeploymentError {
+	return v.Error
+}
+
+// RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultErrorRetireAppDeploymentError includes the requested fields of the GraphQL type RetireAppDeploymentError.
+type RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultErrorRetireAppDeploymentError struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultErrorRetireAppDeploymentError.Message, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultErrorRetireAppDeploymentError) GetMessage() string {
+	return v.Message
+}
+
+// RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOk includes the requested fields of the GraphQL type RetireAppDeploymentOk.
+type RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOk struct {
+	RetiredAppDeployment RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment `json:"retiredAppDeployment"`
+}
+
+// GetRetiredAppDeployment returns RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOk.RetiredAppDeployment, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOk) GetRetiredAppDeployment() RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment {
+	return v.RetiredAppDeployment
+}
+
+// RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment includes the requested fields of the GraphQL type AppDeployment.
+type RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment struct {
+	Id      string              `json:"id"`
+	Name    string              `json:"name"`
+	Version string              `json:"version"`
+	Status  AppDeploymentStatus `json:"status"`
+}
+
+// GetId returns RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment.Id, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment) GetId() string {
+	return v.Id
+}
+
+// GetName returns RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment.Name, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment) GetName() string {
+	return v.Name
+}
+
+// GetVersion returns RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment.Version, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment) GetVersion() string {
+	return v.Version
+}
+
+// GetStatus returns RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment.Status, and is useful for accessing the field via an interface.
+func (v *RetireAppDeploymentRetireAppDeploymentRetireAppDeploymentResultOkRetireAppDeploymentOkRetiredAppDeployment) GetStatus() AppDeploymentStatus {
+	return v.Status
+}
+
+type SchemaCheckInput struct {
+	// Optional context ID to group schema checks together.
+	// Manually approved breaking changes will be memorized for schema checks with the same context id.
+	ContextId string                  `json:"contextId,omitempty"`
+	Github    *GitHubSchemaCheckInput `json:"github,omitempty"`
+	Meta      *SchemaCheckMetaInput   `json:"meta,omitempty"`
+	Sdl       string                  `json:"sdl"`
+	Service   string                  `json:"service"`
+	Target    *TargetReferenceInput   `json:"target,omitempty"`
+}
+
+// GetContextId returns SchemaCheckInput.ContextId, and is useful for accessing the field via an interface.
+func (v *SchemaCheckInput) GetContextId() string { return v.ContextId }
+
+// GetGithub returns SchemaCheckInput.Github, and is useful for accessing the field via an interface.
+func (v *SchemaCheckInput) GetGithub() *GitHubSchemaCheckInput { return v.Github }
+
+// GetMeta returns SchemaCheckInput.Meta, and is useful for accessing the field via an interface.
+func (v *SchemaCheckInput) GetMeta() *SchemaCheckMetaInput { return v.Meta }
+
+// GetSdl returns SchemaCheckInput.Sdl, and is useful for accessing the field via an interface.
+func (v *SchemaCheckInput) GetSdl() string { return v.Sdl }
+
+// GetService returns SchemaCheckInput.Service, and is useful for accessing the field via an interface.
+func (v *SchemaCheckInput) GetService() string { return v.Service }
+
+// GetTarget returns SchemaCheckInput.Target, and is useful for accessing the field via an interface.
+func (v *SchemaCheckInput) GetTarget() *TargetReferenceInput { return v.Target }
+
+type SchemaCheckMetaInput struct {
+	Author string `json:"author"`
+	Commit string `json:"commit"`
+}
+
+// GetAuthor returns SchemaCheckMetaInput.Author, and is useful for accessing the field via an interface.
+func (v *SchemaCheckMetaInput) GetAuthor() string { return v.Author }
+
+// GetCommit returns SchemaCheckMetaInput.Commit, and is useful for accessing the field via an interface.
+func (v *SchemaCheckMetaInput) GetCommit() string { return v.Commit }
+
+// SchemaCheckResponse is returned by SchemaCheck on success.
+type SchemaCheckResponse struct {
+	SchemaCheck SchemaCheckSchemaCheckSchemaCheckPayload `json:"-"`
+}
+
+// GetSchemaCheck returns SchemaCheckResponse.SchemaCheck, and is useful for accessing the field via an interface.
+func (v *SchemaCheckResponse) GetSchemaCheck() SchemaCheckSchemaCheckSchemaCheckPayload {
+	return v.SchemaCheck
+}
+
+func (v *SchemaCheckResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SchemaCheckResponse
+		SchemaCheck json.RawMessage `json:"schemaCheck"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SchemaCheckResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.SchemaCheck
+		src := firstPass.SchemaCheck
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalSchemaCheckSchemaCheckSchemaCheckPayload(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal SchemaCheckResponse.SchemaCheck: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalSchemaCheckResponse struct {
+	SchemaCheck json.RawMessage `json:"schemaCheck"`
+}
+
+func (v *SchemaCheckResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SchemaCheckResponse) __premarshalJSON() (*__premarshalSchemaCheckResponse, error) {
+	var retval __premarshalSchemaCheckResponse
+
+	{
+
+		dst := &retval.SchemaCheck
+		src := v.SchemaCheck
+		var err error
+		*dst, err = __marshalSchemaCheckSchemaCheckSchemaCheckPayload(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal SchemaCheckResponse.SchemaCheck: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// SchemaCheckSchemaCheckGitHubSchemaCheckError includes the requested fields of the GraphQL type GitHubSchemaCheckError.
+type SchemaCheckSchemaCheckGitHubSchemaCheckError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns SchemaCheckSchemaCheckGitHubSchemaCheckError.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckGitHubSchemaCheckError) GetTypename() string { return v.Typename }
+
+// GetMessage returns SchemaCheckSchemaCheckGitHubSchemaCheckError.Message, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckGitHubSchemaCheckError) GetMessage() string { return v.Message }
+
+// SchemaCheckSchemaCheckGitHubSchemaCheckSuccess includes the requested fields of the GraphQL type GitHubSchemaCheckSuccess.
+type SchemaCheckSchemaCheckGitHubSchemaCheckSuccess struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns SchemaCheckSchemaCheckGitHubSchemaCheckSuccess.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckGitHubSchemaCheckSuccess) GetTypename() string { return v.Typename }
+
+// GetMessage returns SchemaCheckSchemaCheckGitHubSchemaCheckSuccess.Message, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckGitHubSchemaCheckSuccess) GetMessage() string { return v.Message }
+
+// SchemaCheckSchemaCheckSchemaCheckError includes the requested fields of the GraphQL type SchemaCheckError.
+type SchemaCheckSchemaCheckSchemaCheckError struct {
+	Typename    string                                                            `json:"__typename"`
+	Valid       bool                                                              `json:"valid"`
+	Errors      SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection `json:"errors"`
+	SchemaCheck SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck                 `json:"-"`
+}
+
+// GetTypename returns SchemaCheckSchemaCheckSchemaCheckError.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckError) GetTypename() string { return v.Typename }
+
+// GetValid returns SchemaCheckSchemaCheckSchemaCheckError.Valid, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckError) GetValid() bool { return v.Valid }
+
+// GetErrors returns SchemaCheckSchemaCheckSchemaCheckError.Errors, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckError) GetErrors() SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection {
+	return v.Errors
+}
+
+// GetSchemaCheck returns SchemaCheckSchemaCheckSchemaCheckError.SchemaCheck, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckError) GetSchemaCheck() SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck {
+	return v.SchemaCheck
+}
+
+func (v *SchemaCheckSchemaCheckSchemaCheckError) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SchemaCheckSchemaCheckSchemaCheckError
+		SchemaCheck json.RawMessage `json:"schemaCheck"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SchemaCheckSchemaCheckSchemaCheckError = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.SchemaCheck
+		src := firstPass.SchemaCheck
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal SchemaCheckSchemaCheckSchemaCheckError.SchemaCheck: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalSchemaCheckSchemaCheckSchemaCheckError struct {
+	Typename string `json:"__typename"`
+
+	Valid bool `json:"valid"`
+
+	Errors SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection `json:"errors"`
+
+	SchemaCheck json.RawMessage `json:"schemaCheck"`
+}
+
+func (v *SchemaCheckSchemaCheckSchemaCheckError) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SchemaCheckSchemaCheckSchemaCheckError) __premarshalJSON() (*__premarshalSchemaCheckSchemaCheckSchemaCheckError, error) {
+	var retval __premarshalSchemaCheckSchemaCheckSchemaCheckError
+
+	retval.Typename = v.Typename
+	retval.Valid = v.Valid
+	retval.Errors = v.Errors
+	{
+
+		dst := &retval.SchemaCheck
+		src := v.SchemaCheck
+		var err error
+		*dst, err = __marshalSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal SchemaCheckSchemaCheckSchemaCheckError.SchemaCheck: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection includes the requested fields of the GraphQL type SchemaErrorConnection.
+type SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection struct {
+	Nodes []SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError `json:"nodes"`
+	Total int                                                                                 `json:"total"`
+}
+
+// GetNodes returns SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection) GetNodes() []SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError {
+	return v.Nodes
+}
+
+// GetTotal returns SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection.Total, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnection) GetTotal() int {
+	return v.Total
+}
+
+// SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError includes the requested fields of the GraphQL type SchemaError.
+type SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError.Message, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorErrorsSchemaErrorConnectionNodesSchemaError) GetMessage() string {
+	return v.Message
+}
+
+// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck includes the requested fields of the GraphQL interface SchemaCheck.
+//
+// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck is implemented by the following types:
+// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck
+// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck
+type SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck interface {
+	implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
+	// GetWebUrl returns the interface-field "webUrl" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The URL of the schema check on the Hive Web App.
+	GetWebUrl() string
+}
+
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck() {
+}
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck() {
+}
+
+func __unmarshalSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck(b []byte, v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "FailedSchemaCheck":
+		*v = new(SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck)
+		return json.Unmarshal(b, *v)
+	case "SuccessfulSchemaCheck":
+		*v = new(SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing SchemaCheck.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalSchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck(v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck:
+		typename = "FailedSchemaCheck"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck:
+		typename = "SuccessfulSchemaCheck"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheck: "%T"`, v)
+	}
+}
+
+// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck includes the requested fields of the GraphQL type FailedSchemaCheck.
+// The GraphQL type's documentation follows.
+//
+// A failed schema check.
+type SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	// The URL of the schema check on the Hive Web App.
+	WebUrl string `json:"webUrl"`
+}
+
+// GetTypename returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck.Id, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck) GetId() string {
+	return v.Id
+}
+
+// GetWebUrl returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckFailedSchemaCheck) GetWebUrl() string {
+	return v.WebUrl
+}
+
+// SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck includes the requested fields of the GraphQL type SuccessfulSchemaCheck.
+// The GraphQL type's documentation follows.
+//
+// A successful schema check.
+type SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	// The URL of the schema check on the Hive Web App.
+	WebUrl string `json:"webUrl"`
+}
+
+// GetTypename returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck.Id, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck) GetId() string {
+	return v.Id
+}
+
+// GetWebUrl returns SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckErrorSchemaCheckSuccessfulSchemaCheck) GetWebUrl() string {
+	return v.WebUrl
+}
+
+// SchemaCheckSchemaCheckSchemaCheckPayload includes the requested fields of the GraphQL interface SchemaCheckPayload.
+//
+// SchemaCheckSchemaCheckSchemaCheckPayload is implemented by the following types:
+// SchemaCheckSchemaCheckGitHubSchemaCheckError
+// SchemaCheckSchemaCheckGitHubSchemaCheckSuccess
+// SchemaCheckSchemaCheckSchemaCheckError
+// SchemaCheckSchemaCheckSchemaCheckSuccess
+type SchemaCheckSchemaCheckSchemaCheckPayload interface {
+	implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *SchemaCheckSchemaCheckGitHubSchemaCheckError) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload() {
+}
+func (v *SchemaCheckSchemaCheckGitHubSchemaCheckSuccess) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload() {
+}
+func (v *SchemaCheckSchemaCheckSchemaCheckError) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload() {
+}
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckPayload() {
+}
+
+func __unmarshalSchemaCheckSchemaCheckSchemaCheckPayload(b []byte, v *SchemaCheckSchemaCheckSchemaCheckPayload) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "GitHubSchemaCheckError":
+		*v = new(SchemaCheckSchemaCheckGitHubSchemaCheckError)
+		return json.Unmarshal(b, *v)
+	case "GitHubSchemaCheckSuccess":
+		*v = new(SchemaCheckSchemaCheckGitHubSchemaCheckSuccess)
+		return json.Unmarshal(b, *v)
+	case "SchemaCheckError":
+		*v = new(SchemaCheckSchemaCheckSchemaCheckError)
+		return json.Unmarshal(b, *v)
+	case "SchemaCheckSuccess":
+		*v = new(SchemaCheckSchemaCheckSchemaCheckSuccess)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing SchemaCheckPayload.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckPayload: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalSchemaCheckSchemaCheckSchemaCheckPayload(v *SchemaCheckSchemaCheckSchemaCheckPayload) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *SchemaCheckSchemaCheckGitHubSchemaCheckError:
+		typename = "GitHubSchemaCheckError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaCheckSchemaCheckGitHubSchemaCheckError
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaCheckSchemaCheckGitHubSchemaCheckSuccess:
+		typename = "GitHubSchemaCheckSuccess"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaCheckSchemaCheckGitHubSchemaCheckSuccess
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaCheckSchemaCheckSchemaCheckError:
+		typename = "SchemaCheckError"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalSchemaCheckSchemaCheckSchemaCheckError
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *SchemaCheckSchemaCheckSchemaCheckSuccess:
+		typename = "SchemaCheckSuccess"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalSchemaCheckSchemaCheckSchemaCheckSuccess
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckPayload: "%T"`, v)
+	}
+}
+
+// SchemaCheckSchemaCheckSchemaCheckSuccess includes the requested fields of the GraphQL type SchemaCheckSuccess.
+type SchemaCheckSchemaCheckSchemaCheckSuccess struct {
+	Typename    string                                              `json:"__typename"`
+	Valid       bool                                                `json:"valid"`
+	Initial     bool                                                `json:"initial"`
+	SchemaCheck SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck `json:"-"`
+}
+
+// GetTypename returns SchemaCheckSchemaCheckSchemaCheckSuccess.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) GetTypename() string { return v.Typename }
+
+// GetValid returns SchemaCheckSchemaCheckSchemaCheckSuccess.Valid, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) GetValid() bool { return v.Valid }
+
+// GetInitial returns SchemaCheckSchemaCheckSchemaCheckSuccess.Initial, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) GetInitial() bool { return v.Initial }
+
+// GetSchemaCheck returns SchemaCheckSchemaCheckSchemaCheckSuccess.SchemaCheck, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) GetSchemaCheck() SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck {
+	return v.SchemaCheck
+}
+
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SchemaCheckSchemaCheckSchemaCheckSuccess
+		SchemaCheck json.RawMessage `json:"schemaCheck"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SchemaCheckSchemaCheckSchemaCheckSuccess = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.SchemaCheck
+		src := firstPass.SchemaCheck
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal SchemaCheckSchemaCheckSchemaCheckSuccess.SchemaCheck: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalSchemaCheckSchemaCheckSchemaCheckSuccess struct {
+	Typename string `json:"__typename"`
+
+	Valid bool `json:"valid"`
+
+	Initial bool `json:"initial"`
+
+	SchemaCheck json.RawMessage `json:"schemaCheck"`
+}
+
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccess) __premarshalJSON() (*__premarshalSchemaCheckSchemaCheckSchemaCheckSuccess, error) {
+	var retval __premarshalSchemaCheckSchemaCheckSchemaCheckSuccess
+
+	retval.Typename = v.Typename
+	retval.Valid = v.Valid
+	retval.Initial = v.Initial
+	{
+
+		dst := &retval.SchemaCheck
+		src := v.SchemaCheck
+		var err error
+		*dst, err = __marshalSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal SchemaCheckSchemaCheckSchemaCheckSuccess.SchemaCheck: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck includes the requested fields of the GraphQL interface SchemaCheck.
+//
+// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck is implemented by the following types:
+// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck
+// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck
+type SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck interface {
+	implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
+	// GetWebUrl returns the interface-field "webUrl" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The URL of the schema check on the Hive Web App.
+	GetWebUrl() string
+}
+
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck() {
+}
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck) implementsGraphQLInterfaceSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck() {
+}
+
+func __unmarshalSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck(b []byte, v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "FailedSchemaCheck":
+		*v = new(SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck)
+		return json.Unmarshal(b, *v)
+	case "SuccessfulSchemaCheck":
+		*v = new(SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing SchemaCheck.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalSchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck(v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck:
+		typename = "FailedSchemaCheck"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck:
+		typename = "SuccessfulSchemaCheck"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheck: "%T"`, v)
+	}
+}
+
+// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck includes the requested fields of the GraphQL type FailedSchemaCheck.
+// The GraphQL type's documentation follows.
+//
+// A failed schema check.
+type SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	// The URL of the schema check on the Hive Web App.
+	WebUrl string `json:"webUrl"`
+}
+
+// GetTypename returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck.Id, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck) GetId() string {
+	return v.Id
+}
+
+// GetWebUrl returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckFailedSchemaCheck) GetWebUrl() string {
+	return v.WebUrl
+}
+
+// SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck includes the requested fields of the GraphQL type SuccessfulSchemaCheck.
+// The GraphQL type's documentation follows.
+//
+// A successful schema check.
+type SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	// The URL of the schema check on the Hive Web App.
+	WebUrl string `json:"webUrl"`
+}
+
+// GetTypename returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck.Id, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck) GetId() string {
+	return v.Id
+}
+
+// GetWebUrl returns SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
+func (v *SchemaCheckSchemaCheckSchemaCheckSuccessSchemaCheckSuccessfulSchemaCheck) GetWebUrl() string {
+	return v.WebUrl
+}
+
+type SchemaChecksFilter struct {
+	Changed *bool `json:"changed,omitempty"`
+	Failed  *bool `json:"failed,omitempty"`
+}
+
+// GetChanged returns SchemaChecksFilter.Changed, and is useful for accessing the field via an interface.
+func (v *SchemaChecksFilter) GetChanged() *bool { return v.Changed }
+
+// GetFailed returns SchemaChecksFilter.Failed, and is useful for accessing the field via an interface.
+func (v *SchemaChecksFilter) GetFailed() *bool { return v.Failed }
+
+// SchemaChecksResponse is returned by SchemaChecks on success.
+type SchemaChecksResponse struct {
+	Target *SchemaChecksTarget `json:"target"`
+}
+
+// GetTarget returns SchemaChecksResponse.Target, and is useful for accessing the field via an interface.
+func (v *SchemaChecksResponse) GetTarget() *SchemaChecksTarget { return v.Target }
+
+// SchemaChecksTarget includes the requested fields of the GraphQL type Target.
+type SchemaChecksTarget struct {
+	Id string `json:"id"`
+	// Get a list of paginated schema checks for a target.
+	SchemaChecks SchemaChecksTargetSchemaChecksSchemaCheckConnection `json:"schemaChecks"`
+}
+
+// GetId returns SchemaChecksTarget.Id, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTarget) GetId() string { return v.Id }
+
+// GetSchemaChecks returns SchemaChecksTarget.SchemaChecks, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTarget) GetSchemaChecks() SchemaChecksTargetSchemaChecksSchemaCheckConnection {
+	return v.SchemaChecks
+}
+
+// SchemaChecksTargetSchemaChecksSchemaCheckConnection includes the requested fields of the GraphQL type SchemaCheckConnection.
+type SchemaChecksTargetSchemaChecksSchemaCheckConnection struct {
+	Edges    []SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge `json:"edges"`
+	PageInfo SchemaChecksTargetSchemaChecksSchemaCheckConnectionPageInfo               `json:"pageInfo"`
+}
+
+// GetEdges returns SchemaChecksTargetSchemaChecksSchemaCheckConnection.Edges, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnection) GetEdges() []SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge {
+	return v.Edges
+}
+
+// GetPageInfo returns SchemaChecksTargetSchemaChecksSchemaCheckConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnection) GetPageInfo() SchemaChecksTargetSchemaChecksSchemaCheckConnectionPageInfo {
+	return v.PageInfo
+}
+
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge includes the requested fields of the GraphQL type SchemaCheckEdge.
+type SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge struct {
+	Node SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck `json:"-"`
+}
+
+// GetNode returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge.Node, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge) GetNode() SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck {
+	return v.Node
+}
+
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge.Node: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge struct {
+	Node json.RawMessage `json:"node"`
+}
+
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge) __premarshalJSON() (*__premarshalSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge, error) {
+	var retval __premarshalSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge
+
+	{
+
+		dst := &retval.Node
+		src := v.Node
+		var err error
+		*dst, err = __marshalSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdge.Node: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck includes the requested fields of the GraphQL type FailedSchemaCheck.
+// The GraphQL type's documentation follows.
+//
+// A failed schema check.
+type SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck struct {
+	Typename  string `json:"__typename"`
+	Id        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	// The name of the service that owns the schema. Is null for non composite project types.
+	ServiceName *string `json:"serviceName"`
+	// Optional context ID to group schema checks together.
+	ContextId *string `json:"contextId"`
+	// The URL of the schema check on the Hive Web App.
+	WebUrl *string `json:"webUrl"`
+	// Whether this schema check has any schema changes.
+	HasSchemaChanges bool `json:"hasSchemaChanges"`
+	// Whether this schema check has any breaking changes.
+	HasUnapprovedBreakingChanges bool `json:"hasUnapprovedBreakingChanges"`
+	// Whether this schema check has any composition errors.
+	HasSchemaCompositionErrors bool `json:"hasSchemaCompositionErrors"`
+	// Meta information about the schema check.
+	Meta *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta `json:"meta"`
+}
+
+// GetTypename returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.Id, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetId() string {
+	return v.Id
+}
+
+// GetCreatedAt returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetCreatedAt() string {
+	return v.CreatedAt
+}
+
+// GetServiceName returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.ServiceName, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetServiceName() *string {
+	return v.ServiceName
+}
+
+// GetContextId returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.ContextId, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetContextId() *string {
+	return v.ContextId
+}
+
+// GetWebUrl returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetWebUrl() *string {
+	return v.WebUrl
+}
+
+// GetHasSchemaChanges returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.HasSchemaChanges, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetHasSchemaChanges() bool {
+	return v.HasSchemaChanges
+}
+
+// GetHasUnapprovedBreakingChanges returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.HasUnapprovedBreakingChanges, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetHasUnapprovedBreakingChanges() bool {
+	return v.HasUnapprovedBreakingChanges
+}
+
+// GetHasSchemaCompositionErrors returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.HasSchemaCompositionErrors, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetHasSchemaCompositionErrors() bool {
+	return v.HasSchemaCompositionErrors
+}
+
+// GetMeta returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck.Meta, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) GetMeta() *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta {
+	return v.Meta
+}
+
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck includes the requested fields of the GraphQL interface SchemaCheck.
+//
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck is implemented by the following types:
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck
+type SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck interface {
+	implementsGraphQLInterfaceSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	// GetId returns the interface-field "id" from its implementation.
+	GetId() string
+	// GetCreatedAt returns the interface-field "createdAt" from its implementation.
+	GetCreatedAt() string
+	// GetServiceName returns the interface-field "serviceName" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The name of the service that owns the schema. Is null for non composite project types.
+	GetServiceName() *string
+	// GetContextId returns the interface-field "contextId" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Optional context ID to group schema checks together.
+	GetContextId() *string
+	// GetWebUrl returns the interface-field "webUrl" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// The URL of the schema check on the Hive Web App.
+	GetWebUrl() *string
+	// GetHasSchemaChanges returns the interface-field "hasSchemaChanges" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Whether this schema check has any schema changes.
+	GetHasSchemaChanges() bool
+	// GetHasUnapprovedBreakingChanges returns the interface-field "hasUnapprovedBreakingChanges" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Whether this schema check has any breaking changes.
+	GetHasUnapprovedBreakingChanges() bool
+	// GetHasSchemaCompositionErrors returns the interface-field "hasSchemaCompositionErrors" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Whether this schema check has any composition errors.
+	GetHasSchemaCompositionErrors() bool
+	// GetMeta returns the interface-field "meta" from its implementation.
+	// The GraphQL interface field's documentation follows.
+	//
+	// Meta information about the schema check.
+	GetMeta() *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta
+}
+
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck) implementsGraphQLInterfaceSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck() {
+}
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) implementsGraphQLInterfaceSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck() {
+}
+
+func __unmarshalSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck(b []byte, v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "FailedSchemaCheck":
+		*v = new(SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck)
+		return json.Unmarshal(b, *v)
+	case "SuccessfulSchemaCheck":
+		*v = new(SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing SchemaCheck.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalSchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck(v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck:
+		typename = "FailedSchemaCheck"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeFailedSchemaCheck
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck:
+		typename = "SuccessfulSchemaCheck"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck: "%T"`, v)
+	}
+}
+
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta includes the requested fields of the GraphQL type SchemaCheckMeta.
+type SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta struct {
+	Author string `json:"author"`
+	Commit string `json:"commit"`
+}
+
+// GetAuthor returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta.Author, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta) GetAuthor() string {
+	return v.Author
+}
+
+// GetCommit returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta.Commit, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta) GetCommit() string {
+	return v.Commit
+}
+
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck includes the requested fields of the GraphQL type SuccessfulSchemaCheck.
+// The GraphQL type's documentation follows.
+//
+// A successful schema check.
+type SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck struct {
+	Typename  string `json:"__typename"`
+	Id        string `json:"id"`
+	CreatedAt string `json:"createdAt"`
+	// The name of the service that owns the schema. Is null for non composite project types.
+	ServiceName *string `json:"serviceName"`
+	// Optional context ID to group schema checks together.
+	ContextId *string `json:"contextId"`
+	// The URL of the schema check on the Hive Web App.
+	WebUrl *string `json:"webUrl"`
+	// Whether this schema check has any schema changes.
+	HasSchemaChanges bool `json:"hasSchemaChanges"`
+	// Whether this schema check has any breaking changes.
+	HasUnapprovedBreakingChanges bool `json:"hasUnapprovedBreakingChanges"`
+	// Whether this schema check has any composition errors.
+	HasSchemaCompositionErrors bool `json:"hasSchemaCompositionErrors"`
+	// Meta information about the schema check.
+	Meta *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta `json:"meta"`
+	// Whether the schema check was manually approved.
+	IsApproved bool `json:"isApproved"`
+	// Comment given when the schema check was approved.
+	ApprovalComment *string `json:"approvalComment"`
+	// The user that approved the schema check.
+	ApprovedBy *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheckApprovedByUser `json:"approvedBy"`
+}
+
+// GetTypename returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.Id, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetId() string {
+	return v.Id
+}
+
+// GetCreatedAt returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.CreatedAt, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetCreatedAt() string {
+	return v.CreatedAt
+}
+
+// GetServiceName returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.ServiceName, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetServiceName() *string {
+	return v.ServiceName
+}
+
+// GetContextId returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.ContextId, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetContextId() *string {
+	return v.ContextId
+}
+
+// GetWebUrl returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.WebUrl, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetWebUrl() *string {
+	return v.WebUrl
+}
+
+// GetHasSchemaChanges returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.HasSchemaChanges, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetHasSchemaChanges() bool {
+	return v.HasSchemaChanges
+}
+
+// GetHasUnapprovedBreakingChanges returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.HasUnapprovedBreakingChanges, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetHasUnapprovedBreakingChanges() bool {
+	return v.HasUnapprovedBreakingChanges
+}
+
+// GetHasSchemaCompositionErrors returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.HasSchemaCompositionErrors, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetHasSchemaCompositionErrors() bool {
+	return v.HasSchemaCompositionErrors
+}
+
+// GetMeta returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.Meta, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetMeta() *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheckMeta {
+	return v.Meta
+}
+
+// GetIsApproved returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.IsApproved, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetIsApproved() bool {
+	return v.IsApproved
+}
+
+// GetApprovalComment returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.ApprovalComment, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetApprovalComment() *string {
+	return v.ApprovalComment
+}
+
+// GetApprovedBy returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck.ApprovedBy, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck) GetApprovedBy() *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheckApprovedByUser {
+	return v.ApprovedBy
+}
+
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheckApprovedByUser includes the requested fields of the GraphQL type User.
+type SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheckApprovedByUser struct {
+	DisplayName string `json:"displayName"`
+}
+
+// GetDisplayName returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheckApprovedByUser.DisplayName, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheckApprovedByUser) GetDisplayName() string {
+	return v.DisplayName
+}
+
+// SchemaChecksTargetSchemaChecksSchemaCheckConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type SchemaChecksTargetSchemaChecksSchemaCheckConnectionPageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+// GetHasNextPage returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns SchemaChecksTargetSchemaChecksSchemaCheckConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *SchemaChecksTargetSchemaChecksSchemaCheckConnectionPageInfo) GetEndCursor() string {
+	return v.EndCursor
+}
+
+// SchemaFieldListStatsFieldListStatsFieldStatsValues includes the requested fields of the GraphQL type FieldStatsValues.
+type SchemaFieldListStatsFieldListStatsFieldStatsValues struct {
+	Type       string  `json:"type"`
+	Field      string  `json:"field"`
+	Argument   *string `json:"argument"`
+	Count      int64   `json:"count"`
+	Percentage float64 `json:"percentage"`
+}
+
+// GetType returns SchemaFieldListStatsFieldListStatsFieldStatsValues.Type, and is useful for accessing the field via an interface.
+func (v *SchemaFieldListStatsFieldListStatsFieldStatsValues) GetType() string { return v.Type }
+
+// GetField returns SchemaFieldListStatsFieldListStatsFieldStatsValues.Field, and is useful for accessing the field via an interface.
+func (v *SchemaFieldListStatsFieldListStatsFieldStatsValues) GetField() string { return v.Field }
+
+// GetArgument returns SchemaFieldListStatsFieldListStatsFieldStatsValues.Argument, and is useful for accessing the field via an interface.
+func (v *SchemaFieldListStatsFieldListStatsFieldStatsValues) GetArgument() *string { return v.Argument }
+
+// GetCount returns SchemaFieldListStatsFieldListStatsFieldStatsValues.Count, and is useful for accessing the field via an interface.
+func (v *SchemaFieldListStatsFieldListStatsFieldStatsValues) GetCount() int64 { return v.Count }
+
+// GetPercentage returns SchemaFieldListStatsFieldListStatsFieldStatsValues.Percentage, and is useful for accessing the field via an interface.
+func (v *SchemaFieldListStatsFieldListStatsFieldStatsValues) GetPercentage() float64 {
+	return v.Percentage
+}
+
+// SchemaFieldListStatsResponse is returned by SchemaFieldListStats on success.
+type SchemaFieldListStatsResponse struct {
+	FieldListStats []SchemaFieldListStatsFieldListStatsFieldStatsValues `json:"fieldListStats"`
+}
+
+// GetFieldListStats returns SchemaFieldListStatsResponse.FieldListStats, and is useful for accessing the field via an interface.
+func (v *SchemaFieldListStatsResponse) GetFieldListStats() []SchemaFieldListStatsFieldListStatsFieldStatsValues {
+	return v.FieldListStats
+}
+
+// SchemaPolicyRulesResponse is returned by SchemaPolicyRules on success.
+type SchemaPolicyRulesResponse struct {
+	SchemaPolicyRules []SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule `json:"schemaPolicyRules"`
+}
+
+// GetSchemaPolicyRules returns SchemaPolicyRulesResponse.SchemaPolicyRules, and is useful for accessing the field via an interface.
+func (v *SchemaPolicyRulesResponse) GetSchemaPolicyRules() []SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule {
+	return v.SchemaPolicyRules
+}
+
+// SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule includes the requested fields of the GraphQL type SchemaPolicyRule.
+type SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule struct {
+	Id               string          `json:"id"`
+	Description      string          `json:"description"`
+	DocumentationUrl *string         `json:"documentationUrl"`
+	Recommended      bool            `json:"recommended"`
+	ConfigJsonSchema json.RawMessage `json:"configJsonSchema"`
+}
+
+// GetId returns SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule.Id, and is useful for accessing the field via an interface.
+func (v *SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule) GetId() string { return v.Id }
+
+// GetDescription returns SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule.Description, and is useful for accessing the field via an interface.
+func (v *SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule) GetDescription() string {
+	return v.Description
+}
+
+// GetDocumentationUrl returns SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule.DocumentationUrl, and is useful for accessing the field via an interface.
+func (v *SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule) GetDocumentationUrl() *string {
+	return v.DocumentationUrl
+}
+
+// GetRecommended returns SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule.Recommended, and is useful for accessing the field via an interface.
+func (v *SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule) GetRecommended() bool {
+	return v.Recommended
+}
+
+// GetConfigJsonSchema returns SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule.ConfigJsonSchema, and is useful for accessing the field via an interface.
+func (v *SchemaPolicyRulesSchemaPolicyRulesSchemaPolicyRule) GetConfigJsonSchema() json.RawMessage {
+	return v.ConfigJsonSchema
+}
+
+type SchemaPublishGitHubInput struct {
+	// The commit sha.
+	Commit string `json:"commit"`
+	// The repository name.
+	Repository string `json:"repository"`
+}
+
+// GetCommit returns SchemaPublishGitHubInput.Commit, and is useful for accessing the field via an interface.
+func (v *SchemaPublishGitHubInput) GetCommit() string { return v.Commit }
+
+// GetRepository returns SchemaPublishGitHubInput.Repository, and is useful for accessing the field via an interface.
+func (v *SchemaPublishGitHubInput) GetRepository() string { return v.Repository }
+
+type SchemaPublishInput struct {
+	Author string `json:"author"`
+	Commit string `json:"commit"`
+	// Accept breaking changes and mark schema as valid (if composable)
+	Experimental_acceptBreakingChanges bool `json:"experimental_acceptBreakingChanges"`
+	Force                              bool `json:"force"`
+	// Link GitHub version to a GitHub commit on a repository.
+	GitHub *SchemaPublishGitHubInput `json:"gitHub,omitempty"`
+	// Talk to GitHub Application and create a check-run
+	Github   bool   `json:"github"`
+	Metadata string `json:"metadata,omitempty"`
+	Sdl      string `json:"sdl"`
+	Service  string `json:"service"`
+	// Whether the CLI supports retrying the schema publish, in case acquiring the schema publish lock fails due to a busy queue.
+	SupportsRetry bool                  `json:"supportsRetry"`
+	Target        *TargetReferenceInput `json:"target,omitempty"`
+	Url           string                `json:"url"`
+}
+
+// GetAuthor returns SchemaPublishInput.Author, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetAuthor() string { return v.Author }
+
+// GetCommit returns SchemaPublishInput.Commit, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetCommit() string { return v.Commit }
+
+// GetExperimental_acceptBreakingChanges returns SchemaPublishInput.Experimental_acceptBreakingChanges, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetExperimental_acceptBreakingChanges() bool {
+	return v.Experimental_acceptBreakingChanges
+}
+
+// GetForce returns SchemaPublishInput.Force, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetForce() bool { return v.Force }
+
+// GetGitHub returns SchemaPublishInput.GitHub, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetGitHub() *SchemaPublishGitHubInput { return v.GitHub }
+
+// GetGithub returns SchemaPublishInput.Github, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetGithub() bool { return v.Github }
+
+// GetMetadata returns SchemaPublishInput.Metadata, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetMetadata() string { return v.Metadata }
+
+// GetSdl returns SchemaPublishInput.Sdl, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetSdl() string { return v.Sdl }
+
+// GetService returns SchemaPublishInput.Service, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetService() string { return v.Service }
+
+// GetSupportsRetry returns SchemaPublishInput.SupportsRetry, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetSupportsRetry() bool { return v.SupportsRetry }
+
+// GetTarget returns SchemaPublishInput.Target, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetTarget() *TargetReferenceInput { return v.Target }
+
+// GetUrl returns SchemaPublishInput.Url, and is useful for accessing the field via an interface.
+func (v *SchemaPublishInput) GetUrl() string { return v.Url }
+
+// SchemaPublishResponse is returned by SchemaPublish on success.
+type SchemaPublishResponse struct {
+	SchemaPublish SchemaPublishSchemaPublishSchemaPublishPayload `json:"-"`
+}
+
+// GetSchemaPublish returns SchemaPublishResponse.SchemaPublish, and is useful for accessing the field via an interface.
+func (v *SchemaPublishResponse) GetSchemaPublish() SchemaPublishSchemaPublishSchemaPublishPayload {
+	return v.SchemaPublish
+}
+
+func (v *SchemaPublishResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SchemaPublishResponse
+		SchemaPublish json.RawMessage `json:"schemaPublish"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SchemaPublishResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.SchemaPublish
+		src := firstPass.SchemaPublish
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalSchemaPublishSchemaPublishSchemaPublishPayload(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal SchemaPublishResponse.SchemaPublish: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalSchemaPublishResponse struct {
+	SchemaPublish json.RawMessage `json:"schemaPublish"`
+}
+
+func (v *SchemaPublishResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SchemaPublishResponse) __premarshalJSON() (*__premarshalSchemaPublishResponse, error) {
+	var retval __premarshalSchemaPublishResponse
+
+	{
+
+		dst := &retval.SchemaPublish
+		src := v.SchemaPublish
+		var err error
+		*dst, err = __marshalSchemaPublishSchemaPublishSchemaPublishPayload(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal SchemaPublishResponse.SchemaPublish: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// SchemaPublishSchemaPublishGitHubSchemaPublishError includes the requested fields of the GraphQL type GitHubSchemaPublishError.
+type SchemaPublishSchemaPublishGitHubSchemaPublishError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns SchemaPublishSchemaPublishGitHubSchemaPublishError.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishGitHubSchemaPublishError) GetTypename() string { return v.Typename }
+
+// GetMessage returns SchemaPublishSchemaPublishGitHubSchemaPublishError.Message, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishGitHubSchemaPublishError) GetMessage() string { return v.Message }
+
+// SchemaPublishSchemaPublishGitHubSchemaPublishSuccess includes the requested fields of the GraphQL type GitHubSchemaPublishSuccess.
+type SchemaPublishSchemaPublishGitHubSchemaPublishSuccess struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns SchemaPublishSchemaPublishGitHubSchemaPublishSuccess.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishGitHubSchemaPublishSuccess) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns SchemaPublishSchemaPublishGitHubSchemaPublishSuccess.Message, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishGitHubSchemaPublishSuccess) GetMessage() string { return v.Message }
+
+// SchemaPublishSchemaPublishSchemaPublishError includes the requested fields of the GraphQL type SchemaPublishError.
+type SchemaPublishSchemaPublishSchemaPublishError struct {
+	Typename      string                                                                  `json:"__typename"`
+	Valid         bool                                                                    `json:"valid"`
+	LinkToWebsite string                                                                  `json:"linkToWebsite"`
+	Errors        SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection `json:"errors"`
+}
+
+// GetTypename returns SchemaPublishSchemaPublishSchemaPublishError.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishError) GetTypename() string { return v.Typename }
+
+// GetValid returns SchemaPublishSchemaPublishSchemaPublishError.Valid, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishError) GetValid() bool { return v.Valid }
+
+// GetLinkToWebsite returns SchemaPublishSchemaPublishSchemaPublishError.LinkToWebsite, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishError) GetLinkToWebsite() string {
+	return v.LinkToWebsite
+}
+
+// GetErrors returns SchemaPublishSchemaPublishSchemaPublishError.Errors, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishError) GetErrors() SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection {
+	return v.Errors
+}
+
+// SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection includes the requested fields of the GraphQL type SchemaErrorConnection.
+type SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection struct {
+	Nodes []SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError `json:"nodes"`
+	Total int                                                                                       `json:"total"`
+}
+
+// GetNodes returns SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection) GetNodes() []SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError {
+	return v.Nodes
+}
+
+// GetTotal returns SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection.Total, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnection) GetTotal() int {
+	return v.Total
+}
+
+// SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError includes the requested fields of the GraphQL type SchemaError.
+type SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError.Message, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishErrorErrorsSchemaErrorConnectionNodesSchemaError) GetMessage() string {
+	return v.Message
+}
+
+// SchemaPublishSchemaPublishSchemaPublishMissingServiceError includes the requested fields of the GraphQL type SchemaPublishMissingServiceError.
+type SchemaPublishSchemaPublishSchemaPublishMissingServiceError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns SchemaPublishSchemaPublishSchemaPublishMissingServiceError.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishMissingServiceError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns SchemaPublishSchemaPublishSchemaPublishMissingServiceError.Message, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishMissingServiceError) GetMessage() string {
+	return v.Message
+}
+
+// SchemaPublishSchemaPublishSchemaPublishMissingUrlError includes the requested fields of the GraphQL type SchemaPublishMissingUrlError.
+type SchemaPublishSchemaPublishSchemaPublishMissingUrlError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns SchemaPublishSchemaPublishSchemaPublishMissingUrlError.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishMissingUrlError) GetTypename() string {
+	return v.Typename
+}
+
+// GetMessage returns SchemaPublishSchemaPublishSchemaPublishMissingUrlError.Message, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishMissingUrlError) GetMessage() string {
+	return v.Message
+}
+
+// SchemaPublishSchemaPublishSchemaPublishPayload includes the requested fields of the GraphQL interface SchemaPublishPayload.
+//
+// SchemaPublishSchemaPublishSchemaPublishPayload is implemented by the following types:
+// SchemaPublishSchemaPublishGitHubSchemaPublishError
+// SchemaPublishSchemaPublishGitHubSchemaPublishSuccess
+// SchemaPublishSchemaPublishSchemaPublishError
+// SchemaPublishSchemaPublishSchemaPublishMissingServiceError
+// SchemaPublishSchemaPublishSchemaPublishMissingUrlError
+// SchemaPublishSchemaPublishSchemaPublishRetry
+// SchemaPublishSchemaPublishSchemaPublishSuccess
+type SchemaPublishSchemaPublishSchemaPublishPayload interface {
+	implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *SchemaPublishSchemaPublishGitHubSchemaPublishError) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+}
+func (v *SchemaPublishSchemaPublishGitHubSchemaPublishSuccess) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+}
+func (v *SchemaPublishSchemaPublishSchemaPublishError) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+}
+func (v *SchemaPublishSchemaPublishSchemaPublishMissingServiceError) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+}
+func (v *SchemaPublishSchemaPublishSchemaPublishMissingUrlError) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+}
+func (v *SchemaPublishSchemaPublishSchemaPublishRetry) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+}
+func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) implementsGraphQLInterfaceSchemaPublishSchemaPublishSchemaPublishPayload() {
+}
+
+func __unmarshalSchemaPublishSchemaPublishSchemaPublishPayload(b []byte, v *SchemaPublishSchemaPublishSchemaPublishPayload) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "GitHubSchemaPublishError":
+		*v = new(SchemaPublishSchemaPublishGitHubSchemaPublishError)
+		return json.Unmarshal(b, *v)
+	case "GitHubSchemaPublishSuccess":
+		*v = new(SchemaPublishSchemaPublishGitHubSchemaPublishSuccess)
+		return json.Unmarshal(b, *v)
+	case "SchemaPublishError":
+		*v = new(SchemaPublishSchemaPublishSchemaPublishError)
+		return json.Unmarshal(b, *v)
+	case "SchemaPublishMissingServiceError":
+		*v = new(SchemaPublishSchemaPublishSchemaPublishMissingServiceError)
+		return json.Unmarshal(b, *v)
+	case "SchemaPublishMissingUrlError":
+		*v = new(SchemaPublishSchemaPublishSchemaPublishMissingUrlError)
+		return json.Unmarshal(b, *v)
+	case "SchemaPublishRetry":
+		*v = new(SchemaPublishSchemaPublishSchemaPublishRetry)
+		return json.Unmarshal(b, *v)
+	case "SchemaPublishSuccess":
+		*v = new(SchemaPublishSchemaPublishSchemaPublishSuccess)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing SchemaPublishPayload.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for SchemaPublishSchemaPublishSchemaPublishPayload: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalSchemaPublishSchemaPublishSchemaPublishPayload(v *SchemaPublishSchemaPublishSchemaPublishPayload) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *SchemaPublishSchemaPublishGitHubSchemaPublishError:
+		typename = "GitHubSchemaPublishError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaPublishSchemaPublishGitHubSchemaPublishError
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaPublishSchemaPublishGitHubSchemaPublishSuccess:
+		typename = "GitHubSchemaPublishSuccess"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaPublishSchemaPublishGitHubSchemaPublishSuccess
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaPublishSchemaPublishSchemaPublishError:
+		typename = "SchemaPublishError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaPublishSchemaPublishSchemaPublishError
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaPublishSchemaPublishSchemaPublishMissingServiceError:
+		typename = "SchemaPublishMissingServiceError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaPublishSchemaPublishSchemaPublishMissingServiceError
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaPublishSchemaPublishSchemaPublishMissingUrlError:
+		typename = "SchemaPublishMissingUrlError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaPublishSchemaPublishSchemaPublishMissingUrlError
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaPublishSchemaPublishSchemaPublishRetry:
+		typename = "SchemaPublishRetry"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaPublishSchemaPublishSchemaPublishRetry
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaPublishSchemaPublishSchemaPublishSuccess:
+		typename = "SchemaPublishSuccess"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaPublishSchemaPublishSchemaPublishSuccess
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for SchemaPublishSchemaPublishSchemaPublishPayload: "%T"`, v)
+	}
+}
+
+// SchemaPublishSchemaPublishSchemaPublishRetry includes the requested fields of the GraphQL type SchemaPublishRetry.
+type SchemaPublishSchemaPublishSchemaPublishRetry struct {
+	Typename string `json:"__typename"`
+	Reason   string `json:"reason"`
+}
+
+// GetTypename returns SchemaPublishSchemaPublishSchemaPublishRetry.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishRetry) GetTypename() string { return v.Typename }
+
+// GetReason returns SchemaPublishSchemaPublishSchemaPublishRetry.Reason, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishRetry) GetReason() string { return v.Reason }
+
+// SchemaPublishSchemaPublishSchemaPublishSuccess includes the requested fields of the GraphQL type SchemaPublishSuccess.
+type SchemaPublishSchemaPublishSchemaPublishSuccess struct {
+	Typename       string `json:"__typename"`
+	Initial        bool   `json:"initial"`
+	Valid          bool   `json:"valid"`
+	SuccessMessage string `json:"successMessage"`
+	LinkToWebsite  string `json:"linkToWebsite"`
+}
+
+// GetTypename returns SchemaPublishSchemaPublishSchemaPublishSuccess.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetTypename() string { return v.Typename }
+
+// GetInitial returns SchemaPublishSchemaPublishSchemaPublishSuccess.Initial, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetInitial() bool { return v.Initial }
+
+// GetValid returns SchemaPublishSchemaPublishSchemaPublishSuccess.Valid, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetValid() bool { return v.Valid }
+
+// GetSuccessMessage returns SchemaPublishSchemaPublishSchemaPublishSuccess.SuccessMessage, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetSuccessMessage() string {
+	return v.SuccessMessage
+}
+
+// GetLinkToWebsite returns SchemaPublishSchemaPublishSchemaPublishSuccess.LinkToWebsite, and is useful for accessing the field via an interface.
+func (v *SchemaPublishSchemaPublishSchemaPublishSuccess) GetLinkToWebsite() string {
+	return v.LinkToWebsite
+}
+
+// SchemaVersionDetails includes the GraphQL fields of SchemaVersion requested by the fragment SchemaVersionDetails.
+type SchemaVersionDetails struct {
+	Id   string    `json:"id"`
+	Date time.Time `json:"date"`
+	// A schema version is valid if the composition and contract compositions are successful.
+	Valid bool `json:"valid"`
+	// Whether this schema version is composable.
+	IsComposable bool    `json:"isComposable"`
+	Sdl          *string `json:"sdl"`
+	Supergraph   *string `json:"supergraph"`
+	// List of tags in the schema version. E.g. when using Federation.
+	// Tags can be used for filtering the schema via contracts.
+	Tags    []string                                    `json:"tags"`
+	Schemas SchemaVersionDetailsSchemasSchemaConnection `json:"schemas"`
+}
+
+// GetId returns SchemaVersionDetails.Id, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetails) GetId() string { return v.Id }
+
+// GetDate returns SchemaVersionDetails.Date, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetails) GetDate() time.Time { return v.Date }
+
+// GetValid returns SchemaVersionDetails.Valid, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetails) GetValid() bool { return v.Valid }
+
+// GetIsComposable returns SchemaVersionDetails.IsComposable, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetails) GetIsComposable() bool { return v.IsComposable }
+
+// GetSdl returns SchemaVersionDetails.Sdl, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetails) GetSdl() *string { return v.Sdl }
+
+// GetSupergraph returns SchemaVersionDetails.Supergraph, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetails) GetSupergraph() *string { return v.Supergraph }
+
+// GetTags returns SchemaVersionDetails.Tags, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetails) GetTags() []string { return v.Tags }
+
+// GetSchemas returns SchemaVersionDetails.Schemas, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetails) GetSchemas() SchemaVersionDetailsSchemasSchemaConnection {
+	return v.Schemas
+}
+
+// SchemaVersionDetailsSchemasSchemaConnection includes the requested fields of the GraphQL type SchemaConnection.
+type SchemaVersionDetailsSchemasSchemaConnection struct {
+	Nodes []SchemaVersionDetailsSchemasSchemaConnectionNodesSchema `json:"-"`
+}
+
+// GetNodes returns SchemaVersionDetailsSchemasSchemaConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetailsSchemasSchemaConnection) GetNodes() []SchemaVersionDetailsSchemasSchemaConnectionNodesSchema {
+	return v.Nodes
+}
+
+func (v *SchemaVersionDetailsSchemasSchemaConnection) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SchemaVersionDetailsSchemasSchemaConnection
+		Nodes []json.RawMessage `json:"nodes"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SchemaVersionDetailsSchemasSchemaConnection = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Nodes
+		src := firstPass.Nodes
+		*dst = make(
+			[]SchemaVersionDetailsSchemasSchemaConnectionNodesSchema,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalSchemaVersionDetailsSchemasSchemaConnectionNodesSchema(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal SchemaVersionDetailsSchemasSchemaConnection.Nodes: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalSchemaVersionDetailsSchemasSchemaConnection struct {
+	Nodes []json.RawMessage `json:"nodes"`
+}
+
+func (v *SchemaVersionDetailsSchemasSchemaConnection) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SchemaVersionDetailsSchemasSchemaConnection) __premarshalJSON() (*__premarshalSchemaVersionDetailsSchemasSchemaConnection, error) {
+	var retval __premarshalSchemaVersionDetailsSchemasSchemaConnection
+
+	{
+
+		dst := &retval.Nodes
+		src := v.Nodes
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalSchemaVersionDetailsSchemasSchemaConnectionNodesSchema(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal SchemaVersionDetailsSchemasSchemaConnection.Nodes: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema includes the requested fields of the GraphQL type CompositeSchema.
+type SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema struct {
+	Typename string  `json:"__typename"`
+	Id       string  `json:"id"`
+	Service  *string `json:"service"`
+	Source   string  `json:"source"`
+	Url      *string `json:"url"`
+}
+
+// GetTypename returns SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema.Id, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema) GetId() string { return v.Id }
+
+// GetService returns SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema.Service, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema) GetService() *string {
+	return v.Service
+}
+
+// GetSource returns SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema.Source, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema) GetSource() string {
+	return v.Source
+}
+
+// GetUrl returns SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema.Url, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema) GetUrl() *string {
+	return v.Url
+}
+
+// SchemaVersionDetailsSchemasSchemaConnectionNodesSchema includes the requested fields of the GraphQL interface Schema.
+//
+// SchemaVersionDetailsSchemasSchemaConnectionNodesSchema is implemented by the following types:
+// SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema
+// SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema
+type SchemaVersionDetailsSchemasSchemaConnectionNodesSchema interface {
+	implementsGraphQLInterfaceSchemaVersionDetailsSchemasSchemaConnectionNodesSchema()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema) implementsGraphQLInterfaceSchemaVersionDetailsSchemasSchemaConnectionNodesSchema() {
+}
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema) implementsGraphQLInterfaceSchemaVersionDetailsSchemasSchemaConnectionNodesSchema() {
+}
+
+func __unmarshalSchemaVersionDetailsSchemasSchemaConnectionNodesSchema(b []byte, v *SchemaVersionDetailsSchemasSchemaConnectionNodesSchema) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "CompositeSchema":
+		*v = new(SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema)
+		return json.Unmarshal(b, *v)
+	case "SingleSchema":
+		*v = new(SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing Schema.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for SchemaVersionDetailsSchemasSchemaConnectionNodesSchema: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalSchemaVersionDetailsSchemasSchemaConnectionNodesSchema(v *SchemaVersionDetailsSchemasSchemaConnectionNodesSchema) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema:
+		typename = "CompositeSchema"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema
+		}{typename, v}
+		return json.Marshal(result)
+	case *SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema:
+		typename = "SingleSchema"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for SchemaVersionDetailsSchemasSchemaConnectionNodesSchema: "%T"`, v)
+	}
+}
+
+// SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema includes the requested fields of the GraphQL type SingleSchema.
+type SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema struct {
+	Typename string `json:"__typename"`
+	Id       string `json:"id"`
+	Source   string `json:"source"`
+}
+
+// GetTypename returns SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema.Typename, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema) GetTypename() string {
+	return v.Typename
+}
+
+// GetId returns SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema.Id, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema) GetId() string { return v.Id }
+
+// GetSource returns SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema.Source, and is useful for accessing the field via an interface.
+func (v *SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema) GetSource() string {
+	return v.Source
+}
+
+// SchemaVersionForActionIdResponse is returned by SchemaVersionForActionId on success.
+type SchemaVersionForActionIdResponse struct {
+	SchemaVersionForActionId *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion `json:"schemaVersionForActionId"`
+}
+
+// GetSchemaVersionForActionId returns SchemaVersionForActionIdResponse.SchemaVersionForActionId, and is useful for accessing the field via an interface.
+func (v *SchemaVersionForActionIdResponse) GetSchemaVersionForActionId() *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion {
+	return v.SchemaVersionForActionId
+}
+
+// SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion includes the requested fields of the GraphQL type SchemaVersion.
+type SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion struct {
+	SchemaVersionDetails `json:"-"`
+}
+
+// GetId returns SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion.Id, and is useful for accessing the field via an interface.
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) GetId() string {
+	return v.SchemaVersionDetails.Id
+}
+
+// GetDate returns SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion.Date, and is useful for accessing the field via an interface.
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) GetDate() time.Time {
+	return v.SchemaVersionDetails.Date
+}
+
+// GetValid returns SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion.Valid, and is useful for accessing the field via an interface.
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) GetValid() bool {
+	return v.SchemaVersionDetails.Valid
+}
+
+// GetIsComposable returns SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion.IsComposable, and is useful for accessing the field via an interface.
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) GetIsComposable() bool {
+	return v.SchemaVersionDetails.IsComposable
+}
+
+// GetSdl returns SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion.Sdl, and is useful for accessing the field via an interface.
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) GetSdl() *string {
+	return v.SchemaVersionDetails.Sdl
+}
+
+// GetSupergraph returns SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion.Supergraph, and is useful for accessing the field via an interface.
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) GetSupergraph() *string {
+	return v.SchemaVersionDetails.Supergraph
+}
+
+// GetTags returns SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion.Tags, and is useful for accessing the field via an interface.
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) GetTags() []string {
+	return v.SchemaVersionDetails.Tags
+}
+
+// GetSchemas returns SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion.Schemas, and is useful for accessing the field via an interface.
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) GetSchemas() SchemaVersionDetailsSchemasSchemaConnection {
+	return v.SchemaVersionDetails.Schemas
+}
+
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SchemaVersionDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion struct {
+	Id string `json:"id"`
+
+	Date time.Time `json:"date"`
+
+	Valid bool `json:"valid"`
+
+	IsComposable bool `json:"isComposable"`
+
+	Sdl *string `json:"sdl"`
+
+	Supergraph *string `json:"supergraph"`
+
+	Tags []string `json:"tags"`
+
+	Schemas SchemaVersionDetailsSchemasSchemaConnection `json:"schemas"`
+}
+
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion) __premarshalJSON() (*__premarshalSchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion, error) {
+	var retval __premarshalSchemaVersionForActionIdSchemaVersionForActionIdSchemaVersion
+
+	retval.Id = v.SchemaVersionDetails.Id
+	retval.Date = v.SchemaVersionDetails.Date
+	retval.Valid = v.SchemaVersionDetails.Valid
+	retval.IsComposable = v.SchemaVersionDetails.IsComposable
+	retval.Sdl = v.SchemaVersionDetails.Sdl
+	retval.Supergraph = v.SchemaVersionDetails.Supergraph
+	retval.Tags = v.SchemaVersionDetails.Tags
+	retval.Schemas = v.SchemaVersionDetails.Schemas
+	return &retval, nil
+}
+
+// SchemaVersionResponse is returned by SchemaVersion on success.
+type SchemaVersionResponse struct {
+	Target *SchemaVersionTarget `json:"target"`
+}
+
+// GetTarget returns SchemaVersionResponse.Target, and is useful for accessing the field via an interface.
+func (v *SchemaVersionResponse) GetTarget() *SchemaVersionTarget { return v.Target }
+
+// SchemaVersionTarget includes the requested fields of the GraphQL type Target.
+type SchemaVersionTarget struct {
+	Id            string                            `json:"id"`
+	SchemaVersion *SchemaVersionTargetSchemaVersion `json:"schemaVersion"`
+}
+
+// GetId returns SchemaVersionTarget.Id, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTarget) GetId() string { return v.Id }
+
+// GetSchemaVersion returns SchemaVersionTarget.SchemaVersion, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTarget) GetSchemaVersion() *SchemaVersionTargetSchemaVersion {
+	return v.SchemaVersion
+}
+
+// SchemaVersionTargetSchemaVersion includes the requested fields of the GraphQL type SchemaVersion.
+type SchemaVersionTargetSchemaVersion struct {
+	SchemaVersionDetails `json:"-"`
+}
+
+// GetId returns SchemaVersionTargetSchemaVersion.Id, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTargetSchemaVersion) GetId() string { return v.SchemaVersionDetails.Id }
+
+// GetDate returns SchemaVersionTargetSchemaVersion.Date, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTargetSchemaVersion) GetDate() time.Time { return v.SchemaVersionDetails.Date }
+
+// GetValid returns SchemaVersionTargetSchemaVersion.Valid, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTargetSchemaVersion) GetValid() bool { return v.SchemaVersionDetails.Valid }
+
+// GetIsComposable returns SchemaVersionTargetSchemaVersion.IsComposable, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTargetSchemaVersion) GetIsComposable() bool {
+	return v.SchemaVersionDetails.IsComposable
+}
+
+// GetSdl returns SchemaVersionTargetSchemaVersion.Sdl, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTargetSchemaVersion) GetSdl() *string { return v.SchemaVersionDetails.Sdl }
+
+// GetSupergraph returns SchemaVersionTargetSchemaVersion.Supergraph, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTargetSchemaVersion) GetSupergraph() *string {
+	return v.SchemaVersionDetails.Supergraph
+}
+
+// GetTags returns SchemaVersionTargetSchemaVersion.Tags, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTargetSchemaVersion) GetTags() []string { return v.SchemaVersionDetails.Tags }
+
+// GetSchemas returns SchemaVersionTargetSchemaVersion.Schemas, and is useful for accessing the field via an interface.
+func (v *SchemaVersionTargetSchemaVersion) GetSchemas() SchemaVersionDetailsSchemasSchemaConnection {
+	return v.SchemaVersionDetails.Schemas
+}
+
+func (v *SchemaVersionTargetSchemaVersion) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*SchemaVersionTargetSchemaVersion
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.SchemaVersionTargetSchemaVersion = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.SchemaVersionDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalSchemaVersionTargetSchemaVersion struct {
+	Id string `json:"id"`
+
+	Date time.Time `json:"date"`
+
+	Valid bool `json:"valid"`
+
+	IsComposable bool `json:"isComposable"`
+
+	Sdl *string `json:"sdl"`
+
+	Supergraph *string `json:"supergraph"`
+
+	Tags []string `json:"tags"`
+
+	Schemas SchemaVersionDetailsSchemasSchemaConnection `json:"schemas"`
+}
+
+func (v *SchemaVersionTargetSchemaVersion) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *SchemaVersionTargetSchemaVersion) __premarshalJSON() (*__premarshalSchemaVersionTargetSchemaVersion, error) {
+	var retval __premarshalSchemaVersionTargetSchemaVersion
+
+	retval.Id = v.SchemaVersionDetails.Id
+	retval.Date = v.SchemaVersionDetails.Date
+	retval.Valid = v.SchemaVersionDetails.Valid
+	retval.IsComposable = v.SchemaVersionDetails.IsComposable
+	retval.Sdl = v.SchemaVersionDetails.Sdl
+	retval.Supergraph = v.SchemaVersionDetails.Supergraph
+	retval.Tags = v.SchemaVersionDetails.Tags
+	retval.Schemas = v.SchemaVersionDetails.Schemas
+	return &retval, nil
+}
+
+// TargetDetails includes the GraphQL fields of Target requested by the fragment TargetDetails.
+type TargetDetails struct {
+	Id   string `json:"id"`
+	Slug string `json:"slug"`
+	// The URL for accessing this target's artifacts via the CDN.
+	CdnUrl string `json:"cdnUrl"`
+	// The endpoint url of the target's explorer instance.
+	GraphqlEndpointUrl *string                                                 `json:"graphqlEndpointUrl"`
+	ValidationSettings TargetDetailsValidationSettingsTargetValidationSettings `json:"validationSettings"`
+}
+
+// GetId returns TargetDetails.Id, and is useful for accessing the field via an interface.
+func (v *TargetDetails) GetId() string { return v.Id }
+
+// GetSlug returns TargetDetails.Slug, and is useful for accessing the field via an interface.
+func (v *TargetDetails) GetSlug() string { return v.Slug }
+
+// GetCdnUrl returns TargetDetails.CdnUrl, and is useful for accessing the field via an interface.
+func (v *TargetDetails) GetCdnUrl() string { return v.CdnUrl }
+
+// GetGraphqlEndpointUrl returns TargetDetails.GraphqlEndpointUrl, and is useful for accessing the field via an interface.
+func (v *TargetDetails) GetGraphqlEndpointUrl() *string { return v.GraphqlEndpointUrl }
+
+// GetValidationSettings returns TargetDetails.ValidationSettings, and is useful for accessing the field via an interface.
+func (v *TargetDetails) GetValidationSettings() TargetDetailsValidationSettingsTargetValidationSettings {
+	return v.ValidationSettings
+}
+
+// TargetDetailsValidationSettingsTargetValidationSettings includes the requested fields of the GraphQL type TargetValidationSettings.
+type TargetDetailsValidationSettingsTargetValidationSettings struct {
+	Enabled bool `json:"enabled"`
+	// Determines which formula is used to determine if a change is considered breaking
+	// or not. Only one formula can be used at a time.
+	BreakingChangeFormula BreakingChangeFormula `json:"breakingChangeFormula"`
+	// If TargetValidationSettings.breakingChangeFormula is PERCENTAGE, then this
+	// is the percent of the total operations over the TargetValidationSettings.period
+	// required for a change to be considered breaking.
+	Percentage float64 `json:"percentage"`
+	Period     int     `json:"period"`
+	// If TargetValidationSettings.breakingChangeFormula is REQUEST_COUNT, then this
+	// is the total number of operations over the TargetValidationSettings.period
+	// required for a change to be considered breaking.
+	RequestCount    int                                                                    `json:"requestCount"`
+	ExcludedClients []string                                                               `json:"excludedClients"`
+	Targets         []TargetDetailsValidationSettingsTargetValidationSettingsTargetsTarget `json:"targets"`
+}
+
+// GetEnabled returns TargetDetailsValidationSettingsTargetValidationSettings.Enabled, and is useful for accessing the field via an interface.
+func (v *TargetDetailsValidationSettingsTargetValidationSettings) GetEnabled() bool { return v.Enabled }
+
+// GetBreakingChangeFormula returns TargetDetailsValidationSettingsTargetValidationSettings.BreakingChangeFormula, and is useful for accessing the field via an interface.
+func (v *TargetDetailsValidationSettingsTargetValidationSettings) GetBreakingChangeFormula() BreakingChangeFormula {
+	return v.BreakingChangeFormula
+}
+
+// GetPercentage returns TargetDetailsValidationSettingsTargetValidationSettings.Percentage, and is useful for accessing the field via an interface.
+func (v *TargetDetailsValidationSettingsTargetValidationSettings) GetPercentage() float64 {
+	return v.Percentage
+}
+
+// GetPeriod returns TargetDetailsValidationSettingsTargetValidationSettings.Period, and is useful for accessing the field via an interface.
+func (v *TargetDetailsValidationSettingsTargetValidationSettings) GetPeriod() int { return v.Period }
+
+// GetRequestCount returns TargetDetailsValidationSettingsTargetValidationSettings.RequestCount, and is useful for accessing the field via an interface.
+func (v *TargetDetailsValidationSettingsTargetValidationSettings) GetRequestCount() int {
+	return v.RequestCount
+}
+
+// GetExcludedClients returns TargetDetailsValidationSettingsTargetValidationSettings.ExcludedClients, and is useful for accessing the field via an interface.
+func (v *TargetDetailsValidationSettingsTargetValidationSettings) GetExcludedClients() []string {
+	return v.ExcludedClients
+}
+
+// GetTargets returns TargetDetailsValidationSettingsTargetValidationSettings.Targets, and is useful for accessing the field via an interface.
+func (v *TargetDetailsValidationSettingsTargetValidationSettings) GetTargets() []TargetDetailsValidationSettingsTargetValidationSettingsTargetsTarget {
+	return v.Targets
+}
+
+// TargetDetailsValidationSettingsTargetValidationSettingsTargetsTarget includes the requested fields of the GraphQL type Target.
+type TargetDetailsValidationSettingsTargetValidationSettingsTargetsTarget struct {
+	Slug string `json:"slug"`
+}
+
+// GetSlug returns TargetDetailsValidationSettingsTargetValidationSettingsTargetsTarget.Slug, and is useful for accessing the field via an interface.
+func (v *TargetDetailsValidationSettingsTargetValidationSettingsTargetsTarget) GetSlug() string {
+	return v.Slug
+}
+
+// Reference to a target.
+type TargetReferenceInput struct {
+	BySelector TargetSelectorInput `json:"bySelector"`
+}
+
+// GetBySelector returns TargetReferenceInput.BySelector, and is useful for accessing the field via an interface.
+func (v *TargetReferenceInput) GetBySelector() TargetSelectorInput { return v.BySelector }
+
+type TargetSelectorInput struct {
+	OrganizationSlug string `json:"organizationSlug"`
+	ProjectSlug      string `json:"projectSlug"`
+	TargetSlug       string `json:"targetSlug"`
+}
+
+// GetOrganizationSlug returns TargetSelectorInput.OrganizationSlug, and is useful for accessing the field via an interface.
+func (v *TargetSelectorInput) GetOrganizationSlug() string { return v.OrganizationSlug }
+
+// GetProjectSlug returns TargetSelectorInput.ProjectSlug, and is useful for accessing the field via an interface.
+func (v *TargetSelectorInput) GetProjectSlug() string { return v.ProjectSlug }
+
+// GetTargetSlug returns TargetSelectorInput.TargetSlug, and is useful for accessing the field via an interface.
+func (v *TargetSelectorInput) GetTargetSlug() string { return v.TargetSlug }
+
+// TokenInfoResponse is returned by TokenInfo on success.
+type TokenInfoResponse struct {
+	TokenInfo TokenInfoTokenInfoTokenInfoPayload `json:"-"`
+}
+
+// GetTokenInfo returns TokenInfoResponse.TokenInfo, and is useful for accessing the field via an interface.
+func (v *TokenInfoResponse) GetTokenInfo() TokenInfoTokenInfoTokenInfoPayload { return v.TokenInfo }
+
+func (v *TokenInfoResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*TokenInfoResponse
+		TokenInfo json.RawMessage `json:"tokenInfo"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.TokenInfoResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.TokenInfo
+		src := firstPass.TokenInfo
+		if len(src) != 0 && string(src) != "null" {
+			err = __unmarshalTokenInfoTokenInfoTokenInfoPayload(
+				src, dst)
+			if err != nil {
+				return fmt.Errorf(
+					"unable to unmarshal TokenInfoResponse.TokenInfo: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalTokenInfoResponse struct {
+	TokenInfo json.RawMessage `json:"tokenInfo"`
+}
+
+func (v *TokenInfoResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *TokenInfoResponse) __premarshalJSON() (*__premarshalTokenInfoResponse, error) {
+	var retval __premarshalTokenInfoResponse
+
+	{
+
+		dst := &retval.TokenInfo
+		src := v.TokenInfo
+		var err error
+		*dst, err = __marshalTokenInfoTokenInfoTokenInfoPayload(
+			&src)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to marshal TokenInfoResponse.TokenInfo: %w", err)
+		}
+	}
+	return &retval, nil
+}
+
+// TokenInfoTokenInfo includes the requested fields of the GraphQL type TokenInfo.
+type TokenInfoTokenInfo struct {
+	Typename             string                         `json:"__typename"`
+	Organization         TokenInfoTokenInfoOrganization `json:"organization"`
+	Project              TokenInfoTokenInfoProject      `json:"project"`
+	Target               TokenInfoTokenInfoTarget       `json:"target"`
+	Token                TokenInfoTokenInfoToken        `json:"token"`
+	RegistryRead         bool                           `json:"registryRead"`
+	RegistryWrite        bool                           `json:"registryWrite"`
+	TargetSettings       bool                           `json:"targetSettings"`
+	OrganizationSettings bool                           `json:"organizationSettings"`
+}
+
+// GetTypename returns TokenInfoTokenInfo.Typename, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfo) GetTypename() string { return v.Typename }
+
+// GetOrganization returns TokenInfoTokenInfo.Organization, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfo) GetOrganization() TokenInfoTokenInfoOrganization { return v.Organization }
+
+// GetProject returns TokenInfoTokenInfo.Project, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfo) GetProject() TokenInfoTokenInfoProject { return v.Project }
+
+// GetTarget returns TokenInfoTokenInfo.Target, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfo) GetTarget() TokenInfoTokenInfoTarget { return v.Target }
+
+// GetToken returns TokenInfoTokenInfo.Token, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfo) GetToken() TokenInfoTokenInfoToken { return v.Token }
+
+// GetRegistryRead returns TokenInfoTokenInfo.RegistryRead, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfo) GetRegistryRead() bool { return v.RegistryRead }
+
+// GetRegistryWrite returns TokenInfoTokenInfo.RegistryWrite, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfo) GetRegistryWrite() bool { return v.RegistryWrite }
+
+// GetTargetSettings returns TokenInfoTokenInfo.TargetSettings, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfo) GetTargetSettings() bool { return v.TargetSettings }
+
+// GetOrganizationSettings returns TokenInfoTokenInfo.OrganizationSettings, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfo) GetOrganizationSettings() bool { return v.OrganizationSettings }
+
+// TokenInfoTokenInfoOrganization includes the requested fields of the GraphQL type Organization.
+type TokenInfoTokenInfoOrganization struct {
+	Id   string `json:"id"`
+	Slug string `json:"slug"`
+}
+
+// GetId returns TokenInfoTokenInfoOrganization.Id, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfoOrganization) GetId() string { return v.Id }
+
+// GetSlug returns TokenInfoTokenInfoOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfoOrganization) GetSlug() string { return v.Slug }
+
+// TokenInfoTokenInfoProject includes the requested fields of the GraphQL type Project.
+type TokenInfoTokenInfoProject struct {
+	Id   string `json:"id"`
+	Slug string `json:"slug"`
+}
+
+// GetId returns TokenInfoTokenInfoProject.Id, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfoProject) GetId() string { return v.Id }
+
+// GetSlug returns TokenInfoTokenInfoProject.Slug, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfoProject) GetSlug() string { return v.Slug }
+
+// TokenInfoTokenInfoTarget includes the requested fields of the GraphQL type Target.
+type TokenInfoTokenInfoTarget struct {
+	Id   string `json:"id"`
+	Slug string `json:"slug"`
+}
+
+// GetId returns TokenInfoTokenInfoTarget.Id, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfoTarget) GetId() string { return v.Id }
+
+// GetSlug returns TokenInfoTokenInfoTarget.Slug, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfoTarget) GetSlug() string { return v.Slug }
+
+// TokenInfoTokenInfoToken includes the requested fields of the GraphQL type Token.
+type TokenInfoTokenInfoToken struct {
+	Name string `json:"name"`
+}
+
+// GetName returns TokenInfoTokenInfoToken.Name, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfoToken) GetName() string { return v.Name }
+
+// TokenInfoTokenInfoTokenInfoPayload includes the requested fields of the GraphQL interface TokenInfoPayload.
+//
+// TokenInfoTokenInfoTokenInfoPayload is implemented by the following types:
+// TokenInfoTokenInfo
+// TokenInfoTokenInfoTokenNotFoundError
+type TokenInfoTokenInfoTokenInfoPayload interface {
+	implementsGraphQLInterfaceTokenInfoTokenInfoTokenInfoPayload()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+}
+
+func (v *TokenInfoTokenInfo) implementsGraphQLInterfaceTokenInfoTokenInfoTokenInfoPayload() {}
+func (v *TokenInfoTokenInfoTokenNotFoundError) implementsGraphQLInterfaceTokenInfoTokenInfoTokenInfoPayload() {
+}
+
+func __unmarshalTokenInfoTokenInfoTokenInfoPayload(b []byte, v *TokenInfoTokenInfoTokenInfoPayload) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "TokenInfo":
+		*v = new(TokenInfoTokenInfo)
+		return json.Unmarshal(b, *v)
+	case "TokenNotFoundError":
+		*v = new(TokenInfoTokenInfoTokenNotFoundError)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing TokenInfoPayload.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for TokenInfoTokenInfoTokenInfoPayload: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalTokenInfoTokenInfoTokenInfoPayload(v *TokenInfoTokenInfoTokenInfoPayload) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *TokenInfoTokenInfo:
+		typename = "TokenInfo"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*TokenInfoTokenInfo
+		}{typename, v}
+		return json.Marshal(result)
+	case *TokenInfoTokenInfoTokenNotFoundError:
+		typename = "TokenNotFoundError"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*TokenInfoTokenInfoTokenNotFoundError
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for TokenInfoTokenInfoTokenInfoPayload: "%T"`, v)
+	}
+}
+
+// TokenInfoTokenInfoTokenNotFoundError includes the requested fields of the GraphQL type TokenNotFoundError.
+type TokenInfoTokenInfoTokenNotFoundError struct {
+	Typename string `json:"__typename"`
+	Message  string `json:"message"`
+}
+
+// GetTypename returns TokenInfoTokenInfoTokenNotFoundError.Typename, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfoTokenNotFoundError) GetTypename() string { return v.Typename }
+
+// GetMessage returns TokenInfoTokenInfoTokenNotFoundError.Message, and is useful for accessing the field via an interface.
+func (v *TokenInfoTokenInfoTokenNotFoundError) GetMessage() string { return v.Message }
+
+// UnusedSchemaResponse is returned by UnusedSchema on success.
+type UnusedSchemaResponse struct {
+	Target *UnusedSchemaTarget `json:"target"`
+}
+
+// GetTarget returns UnusedSchemaResponse.Target, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaResponse) GetTarget() *UnusedSchemaTarget { return v.Target }
+
+// UnusedSchemaTarget includes the requested fields of the GraphQL type Target.
+type UnusedSchemaTarget struct {
+	Id string `json:"id"`
+	// The latest valid (composable) schema version.
+	LatestValidSchemaVersion *UnusedSchemaTargetLatestValidSchemaVersion `json:"latestValidSchemaVersion"`
+}
+
+// GetId returns UnusedSchemaTarget.Id, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTarget) GetId() string { return v.Id }
+
+// GetLatestValidSchemaVersion returns UnusedSchemaTarget.LatestValidSchemaVersion, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTarget) GetLatestValidSchemaVersion() *UnusedSchemaTargetLatestValidSchemaVersion {
+	return v.LatestValidSchemaVersion
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersion includes the requested fields of the GraphQL type SchemaVersion.
+type UnusedSchemaTargetLatestValidSchemaVersion struct {
+	Id               string                                                                              `json:"id"`
+	UnusedSchema     *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer         `json:"unusedSchema"`
+	DeprecatedSchema *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer `json:"deprecatedSchema"`
+}
+
+// GetId returns UnusedSchemaTargetLatestValidSchemaVersion.Id, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersion) GetId() string { return v.Id }
+
+// GetUnusedSchema returns UnusedSchemaTargetLatestValidSchemaVersion.UnusedSchema, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersion) GetUnusedSchema() *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer {
+	return v.UnusedSchema
+}
+
+// GetDeprecatedSchema returns UnusedSchemaTargetLatestValidSchemaVersion.DeprecatedSchema, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersion) GetDeprecatedSchema() *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer {
+	return v.DeprecatedSchema
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer includes the requested fields of the GraphQL type DeprecatedSchemaExplorer.
+type UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer struct {
+	Types []UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType `json:"-"`
+}
+
+// GetTypes returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer.Types, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer) GetTypes() []UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType {
+	return v.Types
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer
+		Types []json.RawMessage `json:"types"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Types
+		src := firstPass.Types
+		*dst = make(
+			[]UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer.Types: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer struct {
+	Types []json.RawMessage `json:"types"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer
+
+	{
+
+		dst := &retval.Types
+		src := v.Types
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorer.Types: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType includes the requested fields of the GraphQL type GraphQLEnumType.
+type UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType struct {
+	Typename                         string `json:"__typename"`
+	ExplorerNamedTypeGraphQLEnumType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLEnumType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLEnumType.Usage
+}
+
+// GetValues returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType.Values, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType) GetValues() []ExplorerNamedTypeValuesGraphQLEnumValue {
+	return v.ExplorerNamedTypeGraphQLEnumType.Values
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLEnumType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+
+	Values []ExplorerNamedTypeValuesGraphQLEnumValue `json:"values"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLEnumType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLEnumType.Usage
+	retval.Values = v.ExplorerNamedTypeGraphQLEnumType.Values
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType includes the requested fields of the GraphQL type GraphQLInputObjectType.
+type UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType struct {
+	Typename                                string `json:"__typename"`
+	ExplorerNamedTypeGraphQLInputObjectType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLInputObjectType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLInputObjectType.Usage
+}
+
+// GetFields returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType.Fields, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType) GetFields() []ExplorerNamedTypeFieldsGraphQLInputField {
+	return v.ExplorerNamedTypeGraphQLInputObjectType.Fields
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLInputObjectType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+
+	Fields []ExplorerNamedTypeFieldsGraphQLInputField `json:"fields"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLInputObjectType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLInputObjectType.Usage
+	retval.Fields = v.ExplorerNamedTypeGraphQLInputObjectType.Fields
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType includes the requested fields of the GraphQL type GraphQLInterfaceType.
+type UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType struct {
+	Typename                              string `json:"__typename"`
+	ExplorerNamedTypeGraphQLInterfaceType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLInterfaceType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLInterfaceType.Usage
+}
+
+// GetFields returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType.Fields, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType) GetFields() []ExplorerNamedTypeFieldsGraphQLField {
+	return v.ExplorerNamedTypeGraphQLInterfaceType.Fields
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLInterfaceType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+
+	Fields []ExplorerNamedTypeFieldsGraphQLField `json:"fields"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLInterfaceType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLInterfaceType.Usage
+	retval.Fields = v.ExplorerNamedTypeGraphQLInterfaceType.Fields
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType includes the requested fields of the GraphQL interface GraphQLNamedType.
+//
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType is implemented by the following types:
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType
+type UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType interface {
+	implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	ExplorerNamedType
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType() {
+}
+
+func __unmarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType(b []byte, v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "GraphQLEnumType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLInputObjectType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLInterfaceType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLObjectType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLScalarType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLUnionType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GraphQLNamedType.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType(v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType:
+		typename = "GraphQLEnumType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLEnumType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType:
+		typename = "GraphQLInputObjectType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInputObjectType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType:
+		typename = "GraphQLInterfaceType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLInterfaceType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType:
+		typename = "GraphQLObjectType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType:
+		typename = "GraphQLScalarType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType:
+		typename = "GraphQLUnionType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLNamedType: "%T"`, v)
+	}
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType includes the requested fields of the GraphQL type GraphQLObjectType.
+type UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType struct {
+	Typename                           string `json:"__typename"`
+	ExplorerNamedTypeGraphQLObjectType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLObjectType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLObjectType.Usage
+}
+
+// GetFields returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType.Fields, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType) GetFields() []ExplorerNamedTypeFieldsGraphQLField {
+	return v.ExplorerNamedTypeGraphQLObjectType.Fields
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLObjectType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+
+	Fields []ExplorerNamedTypeFieldsGraphQLField `json:"fields"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLObjectType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLObjectType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLObjectType.Usage
+	retval.Fields = v.ExplorerNamedTypeGraphQLObjectType.Fields
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType includes the requested fields of the GraphQL type GraphQLScalarType.
+type UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType struct {
+	Typename                           string `json:"__typename"`
+	ExplorerNamedTypeGraphQLScalarType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLScalarType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLScalarType.Usage
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLScalarType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLScalarType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLScalarType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLScalarType.Usage
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType includes the requested fields of the GraphQL type GraphQLUnionType.
+type UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType struct {
+	Typename                          string `json:"__typename"`
+	ExplorerNamedTypeGraphQLUnionType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLUnionType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLUnionType.Usage
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLUnionType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionDeprecatedSchemaDeprecatedSchemaExplorerTypesGraphQLUnionType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLUnionType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLUnionType.Usage
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer includes the requested fields of the GraphQL type UnusedSchemaExplorer.
+type UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer struct {
+	Types []UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType `json:"-"`
+}
+
+// GetTypes returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer.Types, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer) GetTypes() []UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType {
+	return v.Types
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer
+		Types []json.RawMessage `json:"types"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Types
+		src := firstPass.Types
+		*dst = make(
+			[]UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			if len(src) != 0 && string(src) != "null" {
+				err = __unmarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType(
+					src, dst)
+				if err != nil {
+					return fmt.Errorf(
+						"unable to unmarshal UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer.Types: %w", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer struct {
+	Types []json.RawMessage `json:"types"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer
+
+	{
+
+		dst := &retval.Types
+		src := v.Types
+		*dst = make(
+			[]json.RawMessage,
+			len(src))
+		for i, src := range src {
+			dst := &(*dst)[i]
+			var err error
+			*dst, err = __marshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType(
+				&src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"unable to marshal UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorer.Types: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType includes the requested fields of the GraphQL type GraphQLEnumType.
+type UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType struct {
+	Typename                         string `json:"__typename"`
+	ExplorerNamedTypeGraphQLEnumType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLEnumType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLEnumType.Usage
+}
+
+// GetValues returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType.Values, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType) GetValues() []ExplorerNamedTypeValuesGraphQLEnumValue {
+	return v.ExplorerNamedTypeGraphQLEnumType.Values
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLEnumType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+
+	Values []ExplorerNamedTypeValuesGraphQLEnumValue `json:"values"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLEnumType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLEnumType.Usage
+	retval.Values = v.ExplorerNamedTypeGraphQLEnumType.Values
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType includes the requested fields of the GraphQL type GraphQLInputObjectType.
+type UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType struct {
+	Typename                                string `json:"__typename"`
+	ExplorerNamedTypeGraphQLInputObjectType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLInputObjectType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLInputObjectType.Usage
+}
+
+// GetFields returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType.Fields, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType) GetFields() []ExplorerNamedTypeFieldsGraphQLInputField {
+	return v.ExplorerNamedTypeGraphQLInputObjectType.Fields
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLInputObjectType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+
+	Fields []ExplorerNamedTypeFieldsGraphQLInputField `json:"fields"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLInputObjectType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLInputObjectType.Usage
+	retval.Fields = v.ExplorerNamedTypeGraphQLInputObjectType.Fields
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType includes the requested fields of the GraphQL type GraphQLInterfaceType.
+type UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType struct {
+	Typename                              string `json:"__typename"`
+	ExplorerNamedTypeGraphQLInterfaceType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLInterfaceType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLInterfaceType.Usage
+}
+
+// GetFields returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType.Fields, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType) GetFields() []ExplorerNamedTypeFieldsGraphQLField {
+	return v.ExplorerNamedTypeGraphQLInterfaceType.Fields
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLInterfaceType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+
+	Fields []ExplorerNamedTypeFieldsGraphQLField `json:"fields"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLInterfaceType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLInterfaceType.Usage
+	retval.Fields = v.ExplorerNamedTypeGraphQLInterfaceType.Fields
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType includes the requested fields of the GraphQL interface GraphQLNamedType.
+//
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType is implemented by the following types:
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType
+type UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType interface {
+	implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() string
+	ExplorerNamedType
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType() {
+}
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType) implementsGraphQLInterfaceUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType() {
+}
+
+func __unmarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType(b []byte, v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "GraphQLEnumType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLInputObjectType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLInterfaceType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLObjectType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLScalarType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType)
+		return json.Unmarshal(b, *v)
+	case "GraphQLUnionType":
+		*v = new(UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GraphQLNamedType.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType(v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType:
+		typename = "GraphQLEnumType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLEnumType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType:
+		typename = "GraphQLInputObjectType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInputObjectType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType:
+		typename = "GraphQLInterfaceType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLInterfaceType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType:
+		typename = "GraphQLObjectType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType:
+		typename = "GraphQLScalarType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType:
+		typename = "GraphQLUnionType"
+
+		premarshaled, err := v.__premarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType
+		}{typename, premarshaled}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLNamedType: "%T"`, v)
+	}
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType includes the requested fields of the GraphQL type GraphQLObjectType.
+type UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType struct {
+	Typename                           string `json:"__typename"`
+	ExplorerNamedTypeGraphQLObjectType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLObjectType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLObjectType.Usage
+}
+
+// GetFields returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType.Fields, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType) GetFields() []ExplorerNamedTypeFieldsGraphQLField {
+	return v.ExplorerNamedTypeGraphQLObjectType.Fields
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLObjectType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+
+	Fields []ExplorerNamedTypeFieldsGraphQLField `json:"fields"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLObjectType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLObjectType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLObjectType.Usage
+	retval.Fields = v.ExplorerNamedTypeGraphQLObjectType.Fields
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType includes the requested fields of the GraphQL type GraphQLScalarType.
+type UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType struct {
+	Typename                           string `json:"__typename"`
+	ExplorerNamedTypeGraphQLScalarType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLScalarType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLScalarType.Usage
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLScalarType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLScalarType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLScalarType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLScalarType.Usage
+	return &retval, nil
+}
+
+// UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType includes the requested fields of the GraphQL type GraphQLUnionType.
+type UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType struct {
+	Typename                          string `json:"__typename"`
+	ExplorerNamedTypeGraphQLUnionType `json:"-"`
+}
+
+// GetTypename returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType.Typename, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType) GetTypename() string {
+	return v.Typename
+}
+
+// GetName returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType.Name, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType) GetName() string {
+	return v.ExplorerNamedTypeGraphQLUnionType.Name
+}
+
+// GetUsage returns UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType.Usage, and is useful for accessing the field via an interface.
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType) GetUsage() ExplorerNamedTypeUsageSchemaCoordinateUsage {
+	return v.ExplorerNamedTypeGraphQLUnionType.Usage
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.ExplorerNamedTypeGraphQLUnionType)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType struct {
+	Typename string `json:"__typename"`
+
+	Name string `json:"name"`
+
+	Usage ExplorerNamedTypeUsageSchemaCoordinateUsage `json:"usage"`
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType) __premarshalJSON() (*__premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType, error) {
+	var retval __premarshalUnusedSchemaTargetLatestValidSchemaVersionUnusedSchemaUnusedSchemaExplorerTypesGraphQLUnionType
+
+	retval.Typename = v.Typename
+	retval.Name = v.ExplorerNamedTypeGraphQLUnionType.Name
+	retval.Usage = v.ExplorerNamedTypeGraphQLUnionType.Usage
+	return &retval, nil
+}
+
+// UpdateOrgRateLimitResponse is returned by UpdateOrgRateLimit on success.
+type UpdateOrgRateLimitResponse struct {
+	UpdateOrgRateLimit UpdateOrgRateLimitUpdateOrgRateLimitOrganization `json:"updateOrgRateLimit"`
+}
+
+// GetUpdateOrgRateLimit returns UpdateOrgRateLimitResponse.UpdateOrgRateLimit, and is useful for accessing the field via an interface.
+func (v *UpdateOrgRateLimitResponse) GetUpdateOrgRateLimit() UpdateOrgRateLimitUpdateOrgRateLimitOrganization {
+	return v.UpdateOrgRateLimit
+}
+
+// UpdateOrgRateLimitUpdateOrgRateLimitOrganization includes the requested fields of the GraphQL type Organization.
+type UpdateOrgRateLimitUpdateOrgRateLimitOrganization struct {
+	OrganizationDetails `json:"-"`
+}
+
+// GetId returns UpdateOrgRateLimitUpdateOrgRateLimitOrganization.Id, and is useful for accessing the field via an interface.
+func (v *UpdateOrgRateLimitUpdateOrgRateLimitOrganization) GetId() string {
+	return v.OrganizationDetails.Id
+}
+
+// GetSlug returns UpdateOrgRateLimitUpdateOrgRateLimitOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *UpdateOrgRateLimitUpdateOrgRateLimitOrganization) GetSlug() string {
+	return v.OrganizationDetails.Slug
+}
+
+// GetPlan returns UpdateOrgRateLimitUpdateOrgRateLimitOrganization.Plan, and is useful for accessing the field via an interface.
+func (v *UpdateOrgRateLimitUpdateOrgRateLimitOrganization) GetPlan() BillingPlanType {
+	return v.OrganizationDetails.Plan
+}
+
+// GetRateLimit returns UpdateOrgRateLimitUpdateOrgRateLimitOrganization.RateLimit, and is useful for accessing the field via an interface.
+func (v *UpdateOrgRateLimitUpdateOrgRateLimitOrganization) GetRateLimit() OrganizationDetailsRateLimit {
+	return v.OrganizationDetails.RateLimit
+}
+
+func (v *UpdateOrgRateLimitUpdateOrgRateLimitOrganization) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UpdateOrgRateLimitUpdateOrgRateLimitOrganization
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UpdateOrgRateLimitUpdateOrgRateLimitOrganization = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUpdateOrgRateLimitUpdateOrgRateLimitOrganization struct {
+	Id string `json:"id"`
+
+	Slug string `json:"slug"`
+
+	Plan BillingPlanType `json:"plan"`
+
+	RateLimit OrganizationDetailsRateLimit `json:"rateLimit"`
+}
+
+func (v *UpdateOrgRateLimitUpdateOrgRateLimitOrganization) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UpdateOrgRateLimitUpdateOrgRateLimitOrganization) __premarshalJSON() (*__premarshalUpdateOrgRateLimitUpdateOrgRateLimitOrganization, error) {
+	var retval __premarshalUpdateOrgRateLimitUpdateOrgRateLimitOrganization
+
+	retval.Id = v.OrganizationDetails.Id
+	retval.Slug = v.OrganizationDetails.Slug
+	retval.Plan = v.OrganizationDetails.Plan
+	retval.RateLimit = v.OrganizationDetails.RateLimit
+	return &retval, nil
+}
+
+type UpdateOrganizationSlugInput struct {
+	OrganizationSlug string `json:"organizationSlug"`
+	Slug             string `json:"slug"`
+}
+
+// GetOrganizationSlug returns UpdateOrganizationSlugInput.OrganizationSlug, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugInput) GetOrganizationSlug() string { return v.OrganizationSlug }
+
+// GetSlug returns UpdateOrganizationSlugInput.Slug, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugInput) GetSlug() string { return v.Slug }
+
+// UpdateOrganizationSlugResponse is returned by UpdateOrganizationSlug on success.
+type UpdateOrganizationSlugResponse struct {
+	UpdateOrganizationSlug UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResult `json:"updateOrganizationSlug"`
+}
+
+// GetUpdateOrganizationSlug returns UpdateOrganizationSlugResponse.UpdateOrganizationSlug, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugResponse) GetUpdateOrganizationSlug() UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResult {
+	return v.UpdateOrganizationSlug
+}
+
+// UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResult includes the requested fields of the GraphQL type UpdateOrganizationSlugResult.
+type UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResult struct {
+	Ok    *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOk       `json:"ok"`
+	Error *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultErrorUpdateOrganizationSlugError `json:"error"`
+}
+
+// GetOk returns UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResult.Ok, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResult) GetOk() *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOk {
+	return v.Ok
+}
+
+// GetError returns UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResult.Error, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResult) GetError() *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultErrorUpdateOrganizationSlugError {
+	return v.Error
+}
+
+// UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultErrorUpdateOrganizationSlugError includes the requested fields of the GraphQL type UpdateOrganizationSlugError.
+type UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultErrorUpdateOrganizationSlugError struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultErrorUpdateOrganizationSlugError.Message, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultErrorUpdateOrganizationSlugError) GetMessage() string {
+	return v.Message
+}
+
+// UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOk includes the requested fields of the GraphQL type UpdateOrganizationSlugOk.
+type UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOk struct {
+	UpdatedOrganizationPayload UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayload `json:"updatedOrganizationPayload"`
+}
+
+// GetUpdatedOrganizationPayload returns UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOk.UpdatedOrganizationPayload, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOk) GetUpdatedOrganizationPayload() UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayload {
+	return v.UpdatedOrganizationPayload
+}
+
+// UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayload includes the requested fields of the GraphQL type OrganizationPayload.
+type UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayload struct {
+	Organization UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization `json:"organization"`
+}
+
+// GetOrganization returns UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayload.Organization, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayload) GetOrganization() UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization {
+	return v.Organization
+}
+
+// UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization includes the requested fields of the GraphQL type Organization.
+type UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization struct {
+	OrganizationDetails `json:"-"`
+}
+
+// GetId returns UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization.Id, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization) GetId() string {
+	return v.OrganizationDetails.Id
+}
+
+// GetSlug returns UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization.Slug, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization) GetSlug() string {
+	return v.OrganizationDetails.Slug
+}
+
+// GetPlan returns UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization.Plan, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization) GetPlan() BillingPlanType {
+	return v.OrganizationDetails.Plan
+}
+
+// GetRateLimit returns UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization.RateLimit, and is useful for accessing the field via an interface.
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization) GetRateLimit() OrganizationDetailsRateLimit {
+	return v.OrganizationDetails.RateLimit
+}
+
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.OrganizationDetails)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalUpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization struct {
+	Id string `json:"id"`
+
+	Slug string `json:"slug"`
+
+	Plan BillingPlanType `json:"plan"`
+
+	RateLimit OrganizationDetailsRateLimit `json:"rateLimit"`
+}
+
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *UpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization) __premarshalJSON() (*__premarshalUpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization, error) {
+	var retval __premarshalUpdateOrganizationSlugUpdateOrganizationSlugUpdateOrganizationSlugResultOkUpdateOrganizationSlugOkUpdatedOrganizationPayloadOrganization
+
+	retval.Id = v.OrganizationDetails.Id
+	retval.Slug = v.OrganizationDetails.Slug
+	retval.Plan = v.OrganizationDetails.Plan
+	retval.RateLimit = v.OrganizationDetails.RateLimit
+	return &retval, nil
+}
+
+// __ActivateAppDeploymentInput is used internally by genqlient
+type __ActivateAppDeploymentInput struct {
+	Input ActivateAppDeploymentInput `json:"input"`
+}
+
+// GetInput returns __ActivateAppDeploymentInput.Input, and is useful for accessing the field via an interface.
+func (v *__ActivateAppDeploymentInput) GetInput() ActivateAppDeploymentInput { return v.Input }
+
+// __AddDocumentsToAppDeploymentInput is used internally by genqlient
+type __AddDocumentsToAppDeploymentInput struct {
+	Input AddDocumentsToAppDeploymentInput `json:"input"`
+}
+
+// GetInput returns __AddDocumentsToAppDeploymentInput.Input, and is useful for accessing the field via an interface.
+func (v *__AddDocumentsToAppDeploymentInput) GetInput() AddDocumentsToAppDeploymentInput {
+	return v.Input
+}
+
+// __AppDeploymentInput is used internally by genqlient
+type __AppDeploymentInput struct {
+	Selector   TargetSelectorInput `json:"selector"`
+	AppName    string              `json:"appName"`
+	AppVersion string              `json:"appVersion"`
+}
+
+// GetSelector returns __AppDeploymentInput.Selector, and is useful for accessing the field via an interface.
+func (v *__AppDeploymentInput) GetSelector() TargetSelectorInput { return v.Selector }
+
+// GetAppName returns __AppDeploymentInput.AppName, and is useful for accessing the field via an interface.
+func (v *__AppDeploymentInput) GetAppName() string { return v.AppName }
+
+// GetAppVersion returns __AppDeploymentInput.AppVersion, and is useful for accessing the field via an interface.
+func (v *__AppDeploymentInput) GetAppVersion() string { return v.AppVersion }
+
+// __AppDeploymentsInput is used internally by genqlient
+type __AppDeploymentsInput struct {
+	Selector TargetSelectorInput `json:"selector"`
+	After    *string             `json:"after"`
+	First    int                 `json:"first"`
+}
+
+// GetSelector returns __AppDeploymentsInput.Selector, and is useful for accessing the field via an interface.
+func (v *__AppDeploymentsInput) GetSelector() TargetSelectorInput { return v.Selector }
+
+// GetAfter returns __AppDeploymentsInput.After, and is useful for accessing the field via an interface.
+func (v *__AppDeploymentsInput) GetAfter() *string { return v.After }
+
+// GetFirst returns __AppDeploymentsInput.First, and is useful for accessing the field via an interface.
+func (v *__AppDeploymentsInput) GetFirst() int { return v.First }
+
+// __ClientStatsByTargetsInput is used internally by genqlient
+type __ClientStatsByTargetsInput struct {
+	Selector ClientStatsByTargetsInput `json:"selector"`
+}
+
+// GetSelector returns __ClientStatsByTargetsInput.Selector, and is useful for accessing the field via an interface.
+func (v *__ClientStatsByTargetsInput) GetSelector() ClientStatsByTargetsInput { return v.Selector }
+
+// __ClientStatsInput is used internally by genqlient
+type __ClientStatsInput struct {
+	Selector ClientStatsInput `json:"selector"`
+}
+
+// GetSelector returns __ClientStatsInput.Selector, and is useful for accessing the field via an interface.
+func (v *__ClientStatsInput) GetSelector() ClientStatsInput { return v.Selector }
+
+// __CreateAppDeploymentInput is used internally by genqlient
+type __CreateAppDeploymentInput struct {
+	Input CreateAppDeploymentInput `json:"input"`
+}
+
+// GetInput returns __CreateAppDeploymentInput.Input, and is useful for accessing the field via an interface.
+func (v *__CreateAppDeploymentInput) GetInput() CreateAppDeploymentInput { return v.Input }
+
+// __GetOrganizationInput is used internally by genqlient
+type __GetOrganizationInput struct {
+	Selector OrganizationSelectorInput `json:"selector"`
+}
+
+// GetSelector returns __GetOrganizationInput.Selector, and is useful for accessing the field via an interface.
+func (v *__GetOrganizationInput) GetSelector() OrganizationSelectorInput { return v.Selector }
+
+// __GetProjectInput is used internally by genqlient
+type __GetProjectInput struct {
+	Selector ProjectSelectorInput `json:"selector"`
+}
+
+// GetSelector returns __GetProjectInput.Selector, and is useful for accessing the field via an interface.
+func (v *__GetProjectInput) GetSelector() ProjectSelectorInput { return v.Selector }
+
+// __GetTargetInput is used internally by genqlient
+type __GetTargetInput struct {
+	Selector TargetSelectorInput `json:"selector"`
+}
+
+// GetSelector returns __GetTargetInput.Selector, and is useful for accessing the field via an interface.
+func (v *__GetTargetInput) GetSelector() TargetSelectorInput { return v.Selector }
+
+// __LatestSchemaVersionInput is used internally by genqlient
+type __LatestSchemaVersionInput struct {
+	Selector TargetSelectorInput `json:"selector"`
+}
+
+// GetSelector returns __LatestSchemaVersionInput.Selector, and is useful for accessing the field via an interface.
+func (v *__LatestSchemaVersionInput) GetSelector() TargetSelectorInput { return v.Selector }
+
+// __LatestValidSchemaInput is used internally by genqlient
+type __LatestValidSchemaInput struct {
+	Target *TargetReferenceInput `json:"target"`
+}
+
+// GetTarget returns __LatestValidSchemaInput.Target, and is useful for accessing the field via an interface.
+func (v *__LatestValidSchemaInput) GetTarget() *TargetReferenceInput { return v.Target }
+
+// __ListProjectsInput is used internally by genqlient
+type __ListProjectsInput struct {
+	Selector OrganizationSelectorInput `json:"selector"`
+}
+
+// GetSelector returns __ListProjectsInput.Selector, and is useful for accessing the field via an interface.
+func (v *__ListProjectsInput) GetSelector() OrganizationSelectorInput { return v.Selector }
+
+// __ListTargetsInput is used internally by genqlient
+type __ListTargetsInput struct {
+	Selector ProjectSelectorInput `json:"selector"`
+}
+
+// GetSelector returns __ListTargetsInput.Selector, and is useful for accessing the field via an interface.
+func (v *__ListTargetsInput) GetSelector() ProjectSelectorInput { return v.Selector }
+
+// __OperationsStatsInput is used internally by genqlient
+type __OperationsStatsInput struct {
+	Selector OperationsStatsSelectorInput `json:"selector"`
+}
+
+// GetSelector returns __OperationsStatsInput.Selector, and is useful for accessing the field via an interface.
+func (v *__OperationsStatsInput) GetSelector() OperationsStatsSelectorInput { return v.Selector }
+
+// __RetireAppDeploymentInput is used internally by genqlient
+type __RetireAppDeploymentInput struct {
+	Input RetireAppDeploymentInput `json:"input"`
+}
+
+// GetInput returns __RetireAppDeploymentInput.Input, and is useful for accessing the field via an interface.
+func (v *__RetireAppDeploymentInput) GetInput() RetireAppDeploymentInput { return v.Input }
+
+// __SchemaCheckInput is used internally by genqlient
+type __SchemaCheckInput struct {
+	Input SchemaCheckInput `json:"input"`
+}
+
+// GetInput returns __SchemaCheckInput.Input, and is useful for accessing the field via an interface.
+func (v *__SchemaCheckInput) GetInput() SchemaCheckInput { return v.Input }
+
+// __SchemaChecksInput is used internally by genqlient
+type __SchemaChecksInput struct {
+	Selector TargetSelectorInput `json:"selector"`
+	Filters  *SchemaChecksFilter `json:"filters"`
+	After    *string             `json:"after"`
+	First    int                 `json:"first"`
+}
+
+// GetSelector returns __SchemaChecksInput.Selector, and is useful for accessing the field via an interface.
+func (v *__SchemaChecksInput) GetSelector() TargetSelectorInput { return v.Selector }
+
+// GetFilters returns __SchemaChecksInput.Filters, and is useful for accessing the field via an interface.
+func (v *__SchemaChecksInput) GetFilters() *SchemaChecksFilter { return v.Filters }
+
+// GetAfter returns __SchemaChecksInput.After, and is useful for accessing the field via an interface.
+func (v *__SchemaChecksInput) GetAfter() *string { return v.After }
+
+// GetFirst returns __SchemaChecksInput.First, and is useful for accessing the field via an interface.
+func (v *__SchemaChecksInput) GetFirst() int { return v.First }
+
+// __SchemaFieldListStatsInput is used internally by genqlient
+type __SchemaFieldListStatsInput struct {
+	Selector FieldListStatsInput `json:"selector"`
+}
+
+// GetSelector returns __SchemaFieldListStatsInput.Selector, and is useful for accessing the field via an interface.
+func (v *__SchemaFieldListStatsInput) GetSelector() FieldListStatsInput { return v.Selector }
+
+// __SchemaPublishInput is used internally by genqlient
+type __SchemaPublishInput struct {
+	Input         SchemaPublishInput `json:"input"`
+	UsesGitHubApp bool               `json:"usesGitHubApp"`
+}
+
+// GetInput returns __SchemaPublishInput.Input, and is useful for accessing the field via an interface.
+func (v *__SchemaPublishInput) GetInput() SchemaPublishInput { return v.Input }
+
+// GetUsesGitHubApp returns __SchemaPublishInput.UsesGitHubApp, and is useful for accessing the field via an interface.
+func (v *__SchemaPublishInput) GetUsesGitHubApp() bool { return v.UsesGitHubApp }
+
+// __SchemaVersionForActionIdInput is used internally by genqlient
+type __SchemaVersionForActionIdInput struct {
+	ActionId string                `json:"actionId"`
+	Target   *TargetReferenceInput `json:"target"`
+}
+
+// GetActionId returns __SchemaVersionForActionIdInput.ActionId, and is useful for accessing the field via an interface.
+func (v *__SchemaVersionForActionIdInput) GetActionId() string { return v.ActionId }
+
+// GetTarget returns __SchemaVersionForActionIdInput.Target, and is useful for accessing the field via an interface.
+func (v *__SchemaVersionForActionIdInput) GetTarget() *TargetReferenceInput { return v.Target }
+
+// __SchemaVersionInput is used internally by genqlient
+type __SchemaVersionInput struct {
+	Selector TargetSelectorInput `json:"selector"`
+	Id       string              `json:"id"`
+}
+
+// GetSelector returns __SchemaVersionInput.Selector, and is useful for accessing the field via an interface.
+func (v *__SchemaVersionInput) GetSelector() TargetSelectorInput { return v.Selector }
+
+// GetId returns __SchemaVersionInput.Id, and is useful for accessing the field via an interface.
+func (v *__SchemaVersionInput) GetId() string { return v.Id }
+
+// __UnusedSchemaInput is used internally by genqlient
+type __UnusedSchemaInput struct {
+	Selector TargetSelectorInput `json:"selector"`
+	Period   DateRangeInput      `json:"period"`
+}
+
+// GetSelector returns __UnusedSchemaInput.Selector, and is useful for accessing the field via an interface.
+func (v *__UnusedSchemaInput) GetSelector() TargetSelectorInput { return v.Selector }
+
+// GetPeriod returns __UnusedSchemaInput.Period, and is useful for accessing the field via an interface.
+func (v *__UnusedSchemaInput) GetPeriod() DateRangeInput { return v.Period }
+
+// __UpdateOrgRateLimitInput is used internally by genqlient
+type __UpdateOrgRateLimitInput struct {
+	Selector      OrganizationSelectorInput `json:"selector"`
+	MonthlyLimits RateLimitInput            `json:"monthlyLimits"`
+}
+
+// GetSelector returns __UpdateOrgRateLimitInput.Selector, and is useful for accessing the field via an interface.
+func (v *__UpdateOrgRateLimitInput) GetSelector() OrganizationSelectorInput { return v.Selector }
+
+// GetMonthlyLimits returns __UpdateOrgRateLimitInput.MonthlyLimits, and is useful for accessing the field via an interface.
+func (v *__UpdateOrgRateLimitInput) GetMonthlyLimits() RateLimitInput { return v.MonthlyLimits }
+
+// __UpdateOrganizationSlugInput is used internally by genqlient
+type __UpdateOrganizationSlugInput struct {
+	Input UpdateOrganizationSlugInput `json:"input"`
+}
+
+// GetInput returns __UpdateOrganizationSlugInput.Input, and is useful for accessing the field via an interface.
+func (v *__UpdateOrganizationSlugInput) GetInput() UpdateOrganizationSlugInput { return v.Input }
+
+// The mutation executed by ActivateAppDeployment.
+const ActivateAppDeployment_Operation = `
+mutation ActivateAppDeployment ($input: ActivateAppDeploymentInput!) {
+	activateAppDeployment(input: $input) {
+		ok {
+			activatedAppDeployment {
+				id
+				name
+				version
+				status
+			}
+			isSkipped
+		}
+		error {
+			message
+		}
+	}
+}
+`
+
+func ActivateAppDeployment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input ActivateAppDeploymentInput,
+) (data_ *ActivateAppDeploymentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ActivateAppDeployment",
+		Query:  ActivateAppDeployment_Operation,
+		Variables: &__ActivateAppDeploymentInput{
+			Input: input,
+		},
+	}
+
+	data_ = &ActivateAppDeploymentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by AddDocumentsToAppDeployment.
+const AddDocumentsToAppDeployment_Operation = `
+mutation AddDocumentsToAppDeployment ($input: AddDocumentsToAppDeploymentInput!) {
+	addDocumentsToAppDeployment(input: $input) {
+		ok {
+			appDeployment {
+				id
+				name
+				version
+				status
+			}
+		}
+		error {
+			message
+			details {
+				index
+				message
+				__typename
+			}
+		}
+	}
+}
+`
+
+func AddDocumentsToAppDeployment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input AddDocumentsToAppDeploymentInput,
+) (data_ *AddDocumentsToAppDeploymentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "AddDocumentsToAppDeployment",
+		Query:  AddDocumentsToAppDeployment_Operation,
+		Variables: &__AddDocumentsToAppDeploymentInput{
+			Input: input,
+		},
+	}
+
+	data_ = &AddDocumentsToAppDeploymentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by AppDeployment.
+const AppDeployment_Operation = `
+query AppDeployment ($selector: TargetSelectorInput!, $appName: String!, $appVersion: String!) {
+	target(selector: $selector) {
+		id
+		appDeployment(appName: $appName, appVersion: $appVersion) {
+			id
+			name
+			version
+			status
+		}
+	}
+}
+`
+
+func AppDeployment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector TargetSelectorInput,
+	appName string,
+	appVersion string,
+) (data_ *AppDeploymentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "AppDeployment",
+		Query:  AppDeployment_Operation,
+		Variables: &__AppDeploymentInput{
+			Selector:   selector,
+			AppName:    appName,
+			AppVersion: appVersion,
+		},
+	}
+
+	data_ = &AppDeploymentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by AppDeployments.
+const AppDeployments_Operation = `
+query AppDeployments ($selector: TargetSelectorInput!, $after: String, $first: Int!) {
+	target(selector: $selector) {
+		id
+		appDeployments(after: $after, first: $first) {
+			edges {
+				node {
+					id
+					name
+					version
+					status
+					totalDocumentCount
+					lastUsed
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`
+
+func AppDeployments(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector TargetSelectorInput,
+	after *string,
+	first int,
+) (data_ *AppDeploymentsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "AppDeployments",
+		Query:  AppDeployments_Operation,
+		Variables: &__AppDeploymentsInput{
+			Selector: selector,
+			After:    after,
+			First:    first,
+		},
+	}
+
+	data_ = &AppDeploymentsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ClientStats.
+const ClientStats_Operation = `
+query ClientStats ($selector: ClientStatsInput!) {
+	clientStats(selector: $selector) {
+		totalRequests
+		totalVersions
+		operations {
+			nodes {
+				... OperationStatsDetails
+			}
+		}
+	}
+}
+fragment OperationStatsDetails on OperationStatsValues {
+	id
+	name
+	kind
+	operationHash
+	count
+	countOk
+	percentage
+	duration {
+		... DurationDetails
+	}
+}
+fragment DurationDetails on DurationValues {
+	avg
+	p75
+	p90
+	p95
+	p99
+}
+`
+
+func ClientStats(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector ClientStatsInput,
+) (data_ *ClientStatsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ClientStats",
+		Query:  ClientStats_Operation,
+		Variables: &__ClientStatsInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &ClientStatsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ClientStatsByTargets.
+const ClientStatsByTargets_Operation = `
+query ClientStatsByTargets ($selector: ClientStatsByTargetsInput!) {
+	clientStatsByTargets(selector: $selector) {
+		nodes {
+			... ClientStatsDetails
+		}
+	}
+}
+fragment ClientStatsDetails on ClientStatsValues {
+	name
+	count
+	percentage
+	versions {
+		version
+		count
+		percentage
+	}
+}
+`
+
+func ClientStatsByTargets(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector ClientStatsByTargetsInput,
+) (data_ *ClientStatsByTargetsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ClientStatsByTargets",
+		Query:  ClientStatsByTargets_Operation,
+		Variables: &__ClientStatsByTargetsInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &ClientStatsByTargetsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by CreateAppDeployment.
+const CreateAppDeployment_Operation = `
+mutation CreateAppDeployment ($input: CreateAppDeploymentInput!) {
+	createAppDeployment(input: $input) {
+		ok {
+			createdAppDeployment {
+				id
+				name
+				version
+				status
+			}
+		}
+		error {
+			message
+		}
+	}
+}
+`
+
+func CreateAppDeployment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input CreateAppDeploymentInput,
+) (data_ *CreateAppDeploymentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CreateAppDeployment",
+		Query:  CreateAppDeployment_Operation,
+		Variables: &__CreateAppDeploymentInput{
+			Input: input,
+		},
+	}
+
+	data_ = &CreateAppDeploymentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetOrganization.
+const GetOrganization_Operation = `
+query GetOrganization ($selector: OrganizationSelectorInput!) {
+	organization(selector: $selector) {
+		organization {
+			... OrganizationDetails
+		}
+	}
+}
+fragment OrganizationDetails on Organization {
+	id
+	slug
+	plan
+	rateLimit {
+		operations
+		retentionInDays
+		limitedForOperations
+	}
+}
+`
+
+func GetOrganization(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector OrganizationSelectorInput,
+) (data_ *GetOrganizationResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetOrganization",
+		Query:  GetOrganization_Operation,
+		Variables: &__GetOrganizationInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &GetOrganizationResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetProject.
+const GetProject_Operation = `
+query GetProject ($selector: ProjectSelectorInput!) {
+	project(selector: $selector) {
+		... ProjectDetails
+	}
+}
+fragment ProjectDetails on Project {
+	id
+	slug
+	type
+	buildUrl
+	validationUrl
+}
+`
+
+func GetProject(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector ProjectSelectorInput,
+) (data_ *GetProjectResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetProject",
+		Query:  GetProject_Operation,
+		Variables: &__GetProjectInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &GetProjectResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by GetTarget.
+const GetTarget_Operation = `
+query GetTarget ($selector: TargetSelectorInput!) {
+	target(selector: $selector) {
+		... TargetDetails
+	}
+}
+fragment TargetDetails on Target {
+	id
+	slug
+	cdnUrl
+	graphqlEndpointUrl
+	validationSettings {
+		enabled
+		breakingChangeFormula
+		percentage
+		period
+		requestCount
+		excludedClients
+		targets {
+			slug
+		}
+	}
+}
+`
+
+func GetTarget(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector TargetSelectorInput,
+) (data_ *GetTargetResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetTarget",
+		Query:  GetTarget_Operation,
+		Variables: &__GetTargetInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &GetTargetResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by LatestSchemaVersion.
+const LatestSchemaVersion_Operation = `
+query LatestSchemaVersion ($selector: TargetSelectorInput!) {
+	target(selector: $selector) {
+		id
+		latestSchemaVersion {
+			... SchemaVersionDetails
+		}
+		latestValidSchemaVersion {
+			... SchemaVersionDetails
+		}
+	}
+}
+fragment SchemaVersionDetails on SchemaVersion {
+	id
+	date
+	valid
+	isComposable
+	sdl
+	supergraph
+	tags
+	schemas {
+		nodes {
+			__typename
+			... on SingleSchema {
+				id
+				source
+			}
+			... on CompositeSchema {
+				id
+				service
+				source
+				url
+			}
+		}
+	}
+}
+`
+
+func LatestSchemaVersion(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector TargetSelectorInput,
+) (data_ *LatestSchemaVersionResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "LatestSchemaVersion",
+		Query:  LatestSchemaVersion_Operation,
+		Variables: &__LatestSchemaVersionInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &LatestSchemaVersionResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by LatestValidSchema.
+const LatestValidSchema_Operation = `
+query LatestValidSchema ($target: TargetReferenceInput) {
+	latestValidVersion(target: $target) {
+		id
+		sdl
+	}
+}
+`
+
+func LatestValidSchema(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	target *TargetReferenceInput,
+) (data_ *LatestValidSchemaResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "LatestValidSchema",
+		Query:  LatestValidSchema_Operation,
+		Variables: &__LatestValidSchemaInput{
+			Target: target,
+		},
+	}
+
+	data_ = &LatestValidSchemaResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListProjects.
+const ListProjects_Operation = `
+query ListProjects ($selector: OrganizationSelectorInput!) {
+	projects(selector: $selector) {
+		nodes {
+			... ProjectDetails
+		}
+	}
+}
+fragment ProjectDetails on Project {
+	id
+	slug
+	type
+	buildUrl
+	validationUrl
+}
+`
+
+func ListProjects(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector OrganizationSelectorInput,
+) (data_ *ListProjectsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListProjects",
+		Query:  ListProjects_Operation,
+		Variables: &__ListProjectsInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &ListProjectsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by ListTargets.
+const ListTargets_Operation = `
+query ListTargets ($selector: ProjectSelectorInput!) {
+	targets(selector: $selector) {
+		nodes {
+			... TargetDetails
+		}
+	}
+}
+fragment TargetDetails on Target {
+	id
+	slug
+	cdnUrl
+	graphqlEndpointUrl
+	validationSettings {
+		enabled
+		breakingChangeFormula
+		percentage
+		period
+		requestCount
+		excludedClients
+		targets {
+			slug
+		}
+	}
+}
+`
+
+func ListTargets(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector ProjectSelectorInput,
+) (data_ *ListTargetsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListTargets",
+		Query:  ListTargets_Operation,
+		Variables: &__ListTargetsInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &ListTargetsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by OperationsStats.
+const OperationsStats_Operation = `
+query OperationsStats ($selector: OperationsStatsSelectorInput!) {
+	operationsStats(selector: $selector) {
+		totalRequests
+		totalFailures
+		totalOperations
+		duration {
+			... DurationDetails
+		}
+		operations {
+			nodes {
+				... OperationStatsDetails
+			}
+		}
+		clients {
+			nodes {
+				... ClientStatsDetails
+			}
+		}
+	}
+}
+fragment DurationDetails on DurationValues {
+	avg
+	p75
+	p90
+	p95
+	p99
+}
+fragment OperationStatsDetails on OperationStatsValues {
+	id
+	name
+	kind
+	operationHash
+	count
+	countOk
+	percentage
+	duration {
+		... DurationDetails
+	}
+}
+fragment ClientStatsDetails on ClientStatsValues {
+	name
+	count
+	percentage
+	versions {
+		version
+		count
+		percentage
+	}
+}
+`
+
+func OperationsStats(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector OperationsStatsSelectorInput,
+) (data_ *OperationsStatsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "OperationsStats",
+		Query:  OperationsStats_Operation,
+		Variables: &__OperationsStatsInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &OperationsStatsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by RetireAppDeployment.
+const RetireAppDeployment_Operation = `
+mutation RetireAppDeployment ($input: RetireAppDeploymentInput!) {
+	retireAppDeployment(input: $input) {
+		ok {
+			retiredAppDeployment {
+				id
+				name
+				version
+				status
+			}
+		}
+		error {
+			message
+		}
+	}
+}
+`
+
+func RetireAppDeployment(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input RetireAppDeploymentInput,
+) (data_ *RetireAppDeploymentResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "RetireAppDeployment",
+		Query:  RetireAppDeployment_Operation,
+		Variables: &__RetireAppDeploymentInput{
+			Input: input,
+		},
+	}
+
+	data_ = &RetireAppDeploymentResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by SchemaCheck.
+const SchemaCheck_Operation = `
+mutation SchemaCheck ($input: SchemaCheckInput!) {
+	schemaCheck(input: $input) {
+		__typename
+		... on SchemaCheckSuccess {
+			valid
+			initial
+			schemaCheck {
+				__typename
+				id
+				webUrl
+			}
+		}
+		... on SchemaCheckError {
+			valid
+			errors {
+				nodes {
+					message
+				}
+				total
+			}
+			schemaCheck {
+				__typename
+				id
+				webUrl
+			}
+		}
+		... on GitHubSchemaCheckSuccess {
+			message
+		}
+		... on GitHubSchemaCheckError {
+			message
+		}
+	}
+}
+`
+
+func SchemaCheck(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input SchemaCheckInput,
+) (data_ *SchemaCheckResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SchemaCheck",
+		Query:  SchemaCheck_Operation,
+		Variables: &__SchemaCheckInput{
+			Input: input,
+		},
+	}
+
+	data_ = &SchemaCheckResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by SchemaChecks.
+const SchemaChecks_Operation = `
+query SchemaChecks ($selector: TargetSelectorInput!, $filters: SchemaChecksFilter, $after: String, $first: Int!) {
+	target(selector: $selector) {
+		id
+		schemaChecks(after: $after, filters: $filters, first: $first) {
+			edges {
+				node {
+					__typename
+					id
+					createdAt
+					serviceName
+					contextId
+					webUrl
+					hasSchemaChanges
+					hasUnapprovedBreakingChanges
+					hasSchemaCompositionErrors
+					meta {
+						author
+						commit
+					}
+					... on SuccessfulSchemaCheck {
+						isApproved
+						approvalComment
+						approvedBy {
+							displayName
+						}
+					}
+				}
+			}
+			pageInfo {
+				hasNextPage
+				endCursor
+			}
+		}
+	}
+}
+`
+
+func SchemaChecks(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector TargetSelectorInput,
+	filters *SchemaChecksFilter,
+	after *string,
+	first int,
+) (data_ *SchemaChecksResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SchemaChecks",
+		Query:  SchemaChecks_Operation,
+		Variables: &__SchemaChecksInput{
+			Selector: selector,
+			Filters:  filters,
+			After:    after,
+			First:    first,
+		},
+	}
+
+	data_ = &SchemaChecksResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by SchemaFieldListStats.
+const SchemaFieldListStats_Operation = `
+query SchemaFieldListStats ($selector: FieldListStatsInput!) {
+	fieldListStats(selector: $selector) {
+		type
+		field
+		argument
+		count
+		percentage
+	}
+}
+`
+
+func SchemaFieldListStats(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector FieldListStatsInput,
+) (data_ *SchemaFieldListStatsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SchemaFieldListStats",
+		Query:  SchemaFieldListStats_Operation,
+		Variables: &__SchemaFieldListStatsInput{
+			Selector: selector,
+		},
+	}
+
+	data_ = &SchemaFieldListStatsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The query executed by SchemaPolicyRules.
+const SchemaPolicyRules_Operation = `
+query SchemaPolicyRules {
+	schemaPolicyRules {
+		id
+		description
+		documentationUrl
+		recommended
+		configJsonSchema
+	}
+}
+`
+
+func SchemaPolicyRules(
+	ctx_ context.Context,
+	client_ graphql.Client,
+) (data_ *SchemaPolicyRulesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SchemaPolicyRules",
+		Query:  SchemaPolicyRules_Operation,
+	}
+
+	data_ = &SchemaPolicyRulesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by SchemaPublish.
+const SchemaPublish_Operation = `
+mutation SchemaPublish ($input: SchemaPublishInput!, $usesGitHubApp: Boolean!) {
+	schemaPublish(input: $input) {
+		__typename
+		... on SchemaPublishSuccess @skip(if: $usesGitHubApp) {
+			initial
+			valid
+			successMessage: message
+			linkToWebsite
+		}
+		... on SchemaPublishError @skip(if: $usesGitHubApp) {
+			valid
+			linkToWebsite
+			errors {
+				nodes {
+					message
+				}
+				total
+			}
+		}
+		... on SchemaPublishMissingServiceError @skip(if: $usesGitHubApp) {
+			message
+		}
+		... on SchemaPublishMissingUrlError @skip(if: $usesGitHubApp) {
+			message
+		}
+		... on GitHubSchemaPublishSuccess @include(if: $usesGitHubApp) {
+			message
+		}
+		... on GitHubSchemaPublishError @include(if: $usesGitHubApp) {
+			message
+		}
+		... on SchemaPublishRetry {
+			reason
+		}
+	}
 }
+`
 
-// GetInput returns __AddDocumentsToAppDeploymentInput.Input, and is useful for accessing the field via an interface.
-func (v *__AddDocumentsToAppDeploymentInput) GetInput() AddDocumentsToAppDeploymentInput {
-	return v.Input
-}
+func SchemaPublish(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	input SchemaPublishInput,
+	usesGitHubApp bool,
+) (data_ *SchemaPublishResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "SchemaPublish",
+		Query:  SchemaPublish_Operation,
+		Variables: &__SchemaPublishInput{
+			Input:         input,
+			UsesGitHubApp: usesGitHubApp,
+		},
+	}
 
-// __CreateAppDeploymentInput is used internally by genqlient
-type __CreateAppDeploymentInput struct {
-	Input CreateAppDeploymentInput `json:"input"`
-}
+	data_ = &SchemaPublishResponse{}
+	resp_ := &graphql.Response{Data: data_}
 
-// GetInput returns __CreateAppDeploymentInput.Input, and is useful for accessing the field via an interface.
-func (v *__CreateAppDeploymentInput) GetInput() CreateAppDeploymentInput { return v.Input }
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
 
-// __SchemaCheckInput is used internally by genqlient
-type __SchemaCheckInput struct {
-	Input SchemaCheckInput `json:"input"`
+	return data_, err_
 }
 
-// GetInput returns __SchemaCheckInput.Input, and is useful for accessing the field via an interface.
-func (v *__SchemaCheckInput) GetInput() SchemaCheckInput { return v.Input }
-
-// __SchemaPublishInput is used internally by genqlient
-type __SchemaPublishInput struct {
-	Input         SchemaPublishInput `json:"input"`
-	UsesGitHubApp bool               `json:"usesGitHubApp"`
+// The query executed by SchemaVersion.
+const SchemaVersion_Operation = `
+query SchemaVersion ($selector: TargetSelectorInput!, $id: ID!) {
+	target(selector: $selector) {
+		id
+		schemaVersion(id: $id) {
+			... SchemaVersionDetails
+		}
+	}
 }
-
-// GetInput returns __SchemaPublishInput.Input, and is useful for accessing the field via an interface.
-func (v *__SchemaPublishInput) GetInput() SchemaPublishInput { return v.Input }
-
-// GetUsesGitHubApp returns __SchemaPublishInput.UsesGitHubApp, and is useful for accessing the field via an interface.
-func (v *__SchemaPublishInput) GetUsesGitHubApp() bool { return v.UsesGitHubApp }
-
-// The mutation executed by ActivateAppDeployment.
-const ActivateAppDeployment_Operation = `
-mutation ActivateAppDeployment ($input: ActivateAppDeploymentInput!) {
-	activateAppDeployment(input: $input) {
-		ok {
-			activatedAppDeployment {
+fragment SchemaVersionDetails on SchemaVersion {
+	id
+	date
+	valid
+	isComposable
+	sdl
+	supergraph
+	tags
+	schemas {
+		nodes {
+			__typename
+			... on SingleSchema {
 				id
-				name
-				version
-				status
+				source
+			}
+			... on CompositeSchema {
+				id
+				service
+				source
+				url
 			}
-			isSkipped
-		}
-		error {
-			message
 		}
 	}
 }
 `
 
-func ActivateAppDeployment(
+func SchemaVersion(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input ActivateAppDeploymentInput,
-) (data_ *ActivateAppDeploymentResponse, err_ error) {
+	selector TargetSelectorInput,
+	id string,
+) (data_ *SchemaVersionResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ActivateAppDeployment",
-		Query:  ActivateAppDeployment_Operation,
-		Variables: &__ActivateAppDeploymentInput{
-			Input: input,
+		OpName: "SchemaVersion",
+		Query:  SchemaVersion_Operation,
+		Variables: &__SchemaVersionInput{
+			Selector: selector,
+			Id:       id,
 		},
 	}
 
-	data_ = &ActivateAppDeploymentResponse{}
+	data_ = &SchemaVersionResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1640,44 +8730,55 @@ func ActivateAppDeployment(
 	return data_, err_
 }
 
-// The mutation executed by AddDocumentsToAppDeployment.
-const AddDocumentsToAppDeployment_Operation = `
-mutation AddDocumentsToAppDeployment ($input: AddDocumentsToAppDeploymentInput!) {
-	addDocumentsToAppDeployment(input: $input) {
-		ok {
-			appDeployment {
+// The query executed by SchemaVersionForActionId.
+const SchemaVersionForActionId_Operation = `
+query SchemaVersionForActionId ($actionId: ID!, $target: TargetReferenceInput) {
+	schemaVersionForActionId(actionId: $actionId, target: $target) {
+		... SchemaVersionDetails
+	}
+}
+fragment SchemaVersionDetails on SchemaVersion {
+	id
+	date
+	valid
+	isComposable
+	sdl
+	supergraph
+	tags
+	schemas {
+		nodes {
+			__typename
+			... on SingleSchema {
 				id
-				name
-				version
-				status
+				source
 			}
-		}
-		error {
-			message
-			details {
-				index
-				message
-				__typename
+			... on CompositeSchema {
+				id
+				service
+				source
+				url
 			}
 		}
 	}
 }
 `
 
-func AddDocumentsToAppDeployment(
+func SchemaVersionForActionId(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input AddDocumentsToAppDeploymentInput,
-) (data_ *AddDocumentsToAppDeploymentResponse, err_ error) {
+	actionId string,
+	target *TargetReferenceInput,
+) (data_ *SchemaVersionForActionIdResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "AddDocumentsToAppDeployment",
-		Query:  AddDocumentsToAppDeployment_Operation,
-		Variables: &__AddDocumentsToAppDeploymentInput{
-			Input: input,
+		OpName: "SchemaVersionForActionId",
+		Query:  SchemaVersionForActionId_Operation,
+		Variables: &__SchemaVersionForActionIdInput{
+			ActionId: actionId,
+			Target:   target,
 		},
 	}
 
-	data_ = &AddDocumentsToAppDeploymentResponse{}
+	data_ = &SchemaVersionForActionIdResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1689,39 +8790,49 @@ func AddDocumentsToAppDeployment(
 	return data_, err_
 }
 
-// The mutation executed by CreateAppDeployment.
-const CreateAppDeployment_Operation = `
-mutation CreateAppDeployment ($input: CreateAppDeploymentInput!) {
-	createAppDeployment(input: $input) {
-		ok {
-			createdAppDeployment {
+// The query executed by TokenInfo.
+const TokenInfo_Operation = `
+query TokenInfo {
+	tokenInfo {
+		__typename
+		... on TokenInfo {
+			organization {
+				id
+				slug
+			}
+			project {
 				id
+				slug
+			}
+			target {
+				id
+				slug
+			}
+			token {
 				name
-				version
-				status
 			}
+			registryRead: hasTargetScope(scope: REGISTRY_READ)
+			registryWrite: hasTargetScope(scope: REGISTRY_WRITE)
+			targetSettings: hasTargetScope(scope: SETTINGS)
+			organizationSettings: hasOrganizationScope(scope: SETTINGS)
 		}
-		error {
+		... on TokenNotFoundError {
 			message
 		}
 	}
 }
 `
 
-func CreateAppDeployment(
+func TokenInfo(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input CreateAppDeploymentInput,
-) (data_ *CreateAppDeploymentResponse, err_ error) {
+) (data_ *TokenInfoResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "CreateAppDeployment",
-		Query:  CreateAppDeployment_Operation,
-		Variables: &__CreateAppDeploymentInput{
-			Input: input,
-		},
+		OpName: "TokenInfo",
+		Query:  TokenInfo_Operation,
 	}
 
-	data_ = &CreateAppDeploymentResponse{}
+	data_ = &TokenInfoResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1733,58 +8844,128 @@ func CreateAppDeployment(
 	return data_, err_
 }
 
-// The mutation executed by SchemaCheck.
-const SchemaCheck_Operation = `
-mutation SchemaCheck ($input: SchemaCheckInput!) {
-	schemaCheck(input: $input) {
-		__typename
-		... on SchemaCheckSuccess {
-			valid
-			initial
-			schemaCheck {
-				__typename
-				id
-				webUrl
+// The query executed by UnusedSchema.
+const UnusedSchema_Operation = `
+query UnusedSchema ($selector: TargetSelectorInput!, $period: DateRangeInput!) {
+	target(selector: $selector) {
+		id
+		latestValidSchemaVersion {
+			id
+			unusedSchema(usage: {period:$period}) {
+				types {
+					__typename
+					... ExplorerNamedType
+				}
 			}
-		}
-		... on SchemaCheckError {
-			valid
-			errors {
-				nodes {
-					message
+			deprecatedSchema(usage: {period:$period}) {
+				types {
+					__typename
+					... ExplorerNamedType
 				}
-				total
 			}
-			schemaCheck {
-				__typename
-				id
-				webUrl
+		}
+	}
+}
+fragment ExplorerNamedType on GraphQLNamedType {
+	__typename
+	... on GraphQLObjectType {
+		name
+		usage {
+			... ExplorerUsage
+		}
+		fields {
+			... ExplorerField
+		}
+	}
+	... on GraphQLInterfaceType {
+		name
+		usage {
+			... ExplorerUsage
+		}
+		fields {
+			... ExplorerField
+		}
+	}
+	... on GraphQLInputObjectType {
+		name
+		usage {
+			... ExplorerUsage
+		}
+		fields {
+			name
+			isDeprecated
+			deprecationReason
+			usage {
+				... ExplorerUsage
 			}
 		}
-		... on GitHubSchemaCheckSuccess {
-			message
+	}
+	... on GraphQLEnumType {
+		name
+		usage {
+			... ExplorerUsage
 		}
-		... on GitHubSchemaCheckError {
-			message
+		values {
+			name
+			isDeprecated
+			deprecationReason
+			usage {
+				... ExplorerUsage
+			}
+		}
+	}
+	... on GraphQLUnionType {
+		name
+		usage {
+			... ExplorerUsage
+		}
+	}
+	... on GraphQLScalarType {
+		name
+		usage {
+			... ExplorerUsage
+		}
+	}
+}
+fragment ExplorerUsage on SchemaCoordinateUsage {
+	isUsed
+	total
+	usedByClients
+}
+fragment ExplorerField on GraphQLField {
+	name
+	isDeprecated
+	deprecationReason
+	usage {
+		... ExplorerUsage
+	}
+	args {
+		name
+		isDeprecated
+		deprecationReason
+		usage {
+			... ExplorerUsage
 		}
 	}
 }
 `
 
-func SchemaCheck(
+func UnusedSchema(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input SchemaCheckInput,
-) (data_ *SchemaCheckResponse, err_ error) {
+	selector TargetSelectorInput,
+	period DateRangeInput,
+) (data_ *UnusedSchemaResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SchemaCheck",
-		Query:  SchemaCheck_Operation,
-		Variables: &__SchemaCheckInput{
-			Input: input,
+		OpName: "UnusedSchema",
+		Query:  UnusedSchema_Operation,
+		Variables: &__UnusedSchemaInput{
+			Selector: selector,
+			Period:   period,
 		},
 	}
 
-	data_ = &SchemaCheckResponse{}
+	data_ = &UnusedSchemaResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -1796,62 +8977,94 @@ func SchemaCheck(
 	return data_, err_
 }
 
-// The mutation executed by SchemaPublish.
-const SchemaPublish_Operation = `
-mutation SchemaPublish ($input: SchemaPublishInput!, $usesGitHubApp: Boolean!) {
-	schemaPublish(input: $input) {
-		__typename
-		... on SchemaPublishSuccess @skip(if: $usesGitHubApp) {
-			initial
-			valid
-			successMessage: message
-			linkToWebsite
-		}
-		... on SchemaPublishError @skip(if: $usesGitHubApp) {
-			valid
-			linkToWebsite
-			errors {
-				nodes {
-					message
+// The mutation executed by UpdateOrgRateLimit.
+const UpdateOrgRateLimit_Operation = `
+mutation UpdateOrgRateLimit ($selector: OrganizationSelectorInput!, $monthlyLimits: RateLimitInput!) {
+	updateOrgRateLimit(selector: $selector, monthlyLimits: $monthlyLimits) {
+		... OrganizationDetails
+	}
+}
+fragment OrganizationDetails on Organization {
+	id
+	slug
+	plan
+	rateLimit {
+		operations
+		retentionInDays
+		limitedForOperations
+	}
+}
+`
+
+func UpdateOrgRateLimit(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	selector OrganizationSelectorInput,
+	monthlyLimits RateLimitInput,
+) (data_ *UpdateOrgRateLimitResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "UpdateOrgRateLimit",
+		Query:  UpdateOrgRateLimit_Operation,
+		Variables: &__UpdateOrgRateLimitInput{
+			Selector:      selector,
+			MonthlyLimits: monthlyLimits,
+		},
+	}
+
+	data_ = &UpdateOrgRateLimitResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
+// The mutation executed by UpdateOrganizationSlug.
+const UpdateOrganizationSlug_Operation = `
+mutation UpdateOrganizationSlug ($input: UpdateOrganizationSlugInput!) {
+	updateOrganizationSlug(input: $input) {
+		ok {
+			updatedOrganizationPayload {
+				organization {
+					... OrganizationDetails
 				}
-				total
 			}
 		}
-		... on SchemaPublishMissingServiceError @skip(if: $usesGitHubApp) {
-			message
-		}
-		... on SchemaPublishMissingUrlError @skip(if: $usesGitHubApp) {
-			message
-		}
-		... on GitHubSchemaPublishSuccess @include(if: $usesGitHubApp) {
-			message
-		}
-		... on GitHubSchemaPublishError @include(if: $usesGitHubApp) {
+		error {
 			message
 		}
-		... on SchemaPublishRetry {
-			reason
-		}
+	}
+}
+fragment OrganizationDetails on Organization {
+	id
+	slug
+	plan
+	rateLimit {
+		operations
+		retentionInDays
+		limitedForOperations
 	}
 }
 `
 
-func SchemaPublish(
+func UpdateOrganizationSlug(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input SchemaPublishInput,
-	usesGitHubApp bool,
-) (data_ *SchemaPublishResponse, err_ error) {
+	input UpdateOrganizationSlugInput,
+) (data_ *UpdateOrganizationSlugResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "SchemaPublish",
-		Query:  SchemaPublish_Operation,
-		Variables: &__SchemaPublishInput{
-			Input:         input,
-			UsesGitHubApp: usesGitHubApp,
+		OpName: "UpdateOrganizationSlug",
+		Query:  UpdateOrganizationSlug_Operation,
+		Variables: &__UpdateOrganizationSlugInput{
+			Input: input,
 		},
 	}
 
-	data_ = &SchemaPublishResponse{}
+	data_ = &UpdateOrganizationSlugResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveAppDeploymentsDataSource{}
+
+func NewHiveAppDeploymentsDataSource() datasource.DataSource {
+	return &HiveAppDeploymentsDataSource{}
+}
+
+type HiveAppDeploymentsDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveAppDeploymentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_deployments"
+}
+
+type HiveAppDeploymentsDataSourceModel struct {
+	Project        types.String                 `tfsdk:"project"`
+	Target         types.String                 `tfsdk:"target"`
+	Name           types.String                 `tfsdk:"name"`
+	Status         types.String                 `tfsdk:"status"`
+	UnusedSince    types.String                 `tfsdk:"unused_since"`
+	Id             types.String                 `tfsdk:"id"`
+	AppDeployments []HiveAppDeploymentDataModel `tfsdk:"app_deployments"`
+}
+
+type HiveAppDeploymentDataModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Version            types.String `tfsdk:"version"`
+	Status             types.String `tfsdk:"status"`
+	TotalDocumentCount types.Int64  `tfsdk:"total_document_count"`
+	LastUsed           types.String `tfsdk:"last_used"`
+}
+
+func (d *HiveAppDeploymentsDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to list the app deployments of a target, including when they were last used",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
+				Optional:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Only return the app deployments with this app name",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only return the app deployments with this status, one of `pending`, `active` or `retired`",
+				Optional:            true,
+			},
+			"unused_since": schema.StringAttribute{
+				MarkdownDescription: "Only return the app deployments that haven't been used within this duration, for example `720h` or `30d`. " +
+					"App deployments that were never used are included as well.",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The target ID",
+			},
+			"app_deployments": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching app deployments",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The app deployment ID",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The app name",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "The app version",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the app deployment",
+							Computed:            true,
+						},
+						"total_document_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of documents within the app deployment",
+							Computed:            true,
+						},
+						"last_used": schema.StringAttribute{
+							MarkdownDescription: "The last time the app deployment was used (RFC 3339), null when it was never used",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *HiveAppDeploymentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HiveAppDeploymentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveAppDeploymentsDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, data.Project, data.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, data.Target, sdk.ScopeRegistryRead, "list app deployments")...)
+
+	var unusedSince *time.Time
+	if !data.UnusedSince.IsNull() {
+		duration, err := parseDuration(data.UnusedSince.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("unused_since"), "Invalid duration", err.Error())
+			return
+		}
+		since := time.Now().Add(-duration)
+		unusedSince = &since
+	}
+
+	result, err := r.client.ListApps(ctx, &sdk.ListAppsInput{
+		Project: data.Project.ValueString(),
+		Target:  data.Target.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Listing app deployments failed", err))
+		return
+	}
+
+	data.Id = types.StringValue(result.TargetId)
+	data.AppDeployments = []HiveAppDeploymentDataModel{}
+	for _, app := range result.AppDeployments {
+		if !data.Name.IsNull() && app.AppName != data.Name.ValueString() {
+			continue
+		}
+		if !data.Status.IsNull() && app.Status != data.Status.ValueString() {
+			continue
+		}
+		if unusedSince != nil && app.LastUsed != nil && app.LastUsed.After(*unusedSince) {
+			continue
+		}
+
+		lastUsed := types.StringNull()
+		if app.LastUsed != nil {
+			lastUsed = types.StringValue(app.LastUsed.Format(time.RFC3339))
+		}
+
+		data.AppDeployments = append(data.AppDeployments, HiveAppDeploymentDataModel{
+			Id:                 types.StringValue(app.Id),
+			Name:               types.StringValue(app.AppName),
+			Version:            types.StringValue(app.AppVersion),
+			Status:             types.StringValue(app.Status),
+			TotalDocumentCount: types.Int64Value(int64(app.TotalDocumentCount)),
+			LastUsed:           lastUsed,
+		})
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveClientStatsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &HiveClientStatsDataSource{}
+
+func NewHiveClientStatsDataSource() datasource.DataSource {
+	return &HiveClientStatsDataSource{}
+}
+
+type HiveClientStatsDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveClientStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_client_stats"
+}
+
+type HiveClientStatsDataSourceModel struct {
+	Project       types.String                  `tfsdk:"project"`
+	Target        types.String                  `tfsdk:"target"`
+	Period        types.String                  `tfsdk:"period"`
+	From          types.String                  `tfsdk:"from"`
+	To            types.String                  `tfsdk:"to"`
+	Client        types.String                  `tfsdk:"client"`
+	TotalRequests types.Int64                   `tfsdk:"total_requests"`
+	Clients       []HiveClientStatsDataModel    `tfsdk:"clients"`
+	Operations    []HiveOperationStatsDataModel `tfsdk:"operations"`
+}
+
+func (d *HiveClientStatsDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to read the request statistics of the clients of a target within a period, including the versions of each client. " +
+			"Set `client` to read a single client, including the operations it sent.",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
+				Optional:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
+				Optional:            true,
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "The period up to now to read the statistics of, for example `1h` or `7d`. " +
+					"Defaults to `30d` when `from` isn't set.",
+				Optional: true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The start of the period to read the statistics of (RFC 3339), conflicts with `period`",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The end of the period to read the statistics of (RFC 3339), defaults to now. Requires `from` to be set.",
+				Optional:            true,
+			},
+			"client": schema.StringAttribute{
+				MarkdownDescription: "Only return the statistics of the client with this name. " +
+					"A warning is given when the client didn't send any requests within the period.",
+				Optional: true,
+			},
+			"total_requests": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests of the returned clients",
+				Computed:            true,
+			},
+			"clients": schema.ListNestedAttribute{
+				MarkdownDescription: "The statistics per client",
+				Computed:            true,
+				NestedObject:        clientStatsObject(),
+			},
+			"operations": schema.ListNestedAttribute{
+				MarkdownDescription: "The statistics per operation sent by the client, only set when `client` is set",
+				Computed:            true,
+				NestedObject:        operationStatsObject(),
+			},
+		},
+	}
+}
+
+func (r *HiveClientStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the period.
+func (r *HiveClientStatsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data HiveClientStatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, diags := usagePeriod(data.Period, data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HiveClientStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveClientStatsDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, data.Project, data.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, data.Target, sdk.ScopeRegistryRead, "read client statistics")...)
+
+	from, to, diags := usagePeriod(data.Period, data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clients, err := r.client.ClientStats(ctx, &sdk.ClientStatsInput{
+		Project: data.Project.ValueString(),
+		Target:  data.Target.ValueString(),
+		From:    from,
+		To:      to,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading client statistics failed", err))
+		return
+	}
+
+	var totalRequests int64
+	matching := []sdk.ClientStats{}
+	for _, client := range clients {
+		if !data.Client.IsNull() && client.Name != data.Client.ValueString() {
+			continue
+		}
+		totalRequests += client.Count
+		matching = append(matching, client)
+	}
+
+	// A client without requests within the period isn't listed by Hive, so
+	// this may be a typo as well as an unused client.
+	if !data.Client.IsNull() && len(matching) == 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("client"),
+			"Client not found",
+			fmt.Sprintf("The client %q didn't send any requests within the period. Check the client name when it should have.", data.Client.ValueString()),
+		)
+	}
+
+	data.Operations = []HiveOperationStatsDataModel{}
+	if len(matching) > 0 && !data.Client.IsNull() {
+		details, err := r.client.ClientDetails(ctx, &sdk.ClientDetailsInput{
+			Project: data.Project.ValueString(),
+			Target:  data.Target.ValueString(),
+			From:    from,
+			To:      to,
+			Client:  data.Client.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.Append(newErrorDiagnostic("Reading client statistics failed", err))
+			return
+		}
+
+		totalRequests = details.TotalRequests
+		data.Operations = newOperationStatsDataModels(details.Operations)
+	}
+
+	data.TotalRequests = types.Int64Value(totalRequests)
+	data.Clients = newClientStatsDataModels(matching)
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,397 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveOperationsStatsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &HiveOperationsStatsDataSource{}
+
+func NewHiveOperationsStatsDataSource() datasource.DataSource {
+	return &HiveOperationsStatsDataSource{}
+}
+
+type HiveOperationsStatsDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveOperationsStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_operations_stats"
+}
+
+type HiveOperationsStatsDataSourceModel struct {
+	Project         types.String                  `tfsdk:"project"`
+	Target          types.String                  `tfsdk:"target"`
+	Period          types.String                  `tfsdk:"period"`
+	From            types.String                  `tfsdk:"from"`
+	To              types.String                  `tfsdk:"to"`
+	ClientNames     types.List                    `tfsdk:"client_names"`
+	OperationIds    types.List                    `tfsdk:"operation_ids"`
+	TotalRequests   types.Int64                   `tfsdk:"total_requests"`
+	TotalFailures   types.Int64                   `tfsdk:"total_failures"`
+	FailureRate     types.Float64                 `tfsdk:"failure_rate"`
+	TotalOperations types.Int64                   `tfsdk:"total_operations"`
+	Duration        *HiveDurationDataModel        `tfsdk:"duration"`
+	Operations      []HiveOperationStatsDataModel `tfsdk:"operations"`
+	Clients         []HiveClientStatsDataModel    `tfsdk:"clients"`
+}
+
+type HiveDurationDataModel struct {
+	Avg types.Int64 `tfsdk:"avg"`
+	P75 types.Int64 `tfsdk:"p75"`
+	P90 types.Int64 `tfsdk:"p90"`
+	P95 types.Int64 `tfsdk:"p95"`
+	P99 types.Int64 `tfsdk:"p99"`
+}
+
+type HiveOperationStatsDataModel struct {
+	Id          types.String          `tfsdk:"id"`
+	Name        types.String          `tfsdk:"name"`
+	Kind        types.String          `tfsdk:"kind"`
+	Hash        types.String          `tfsdk:"hash"`
+	Count       types.Int64           `tfsdk:"count"`
+	CountOk     types.Int64           `tfsdk:"count_ok"`
+	FailureRate types.Float64         `tfsdk:"failure_rate"`
+	Percentage  types.Float64         `tfsdk:"percentage"`
+	Duration    HiveDurationDataModel `tfsdk:"duration"`
+}
+
+type HiveClientStatsDataModel struct {
+	Name       types.String                      `tfsdk:"name"`
+	Count      types.Int64                       `tfsdk:"count"`
+	Percentage types.Float64                     `tfsdk:"percentage"`
+	Versions   []HiveClientVersionStatsDataModel `tfsdk:"versions"`
+}
+
+type HiveClientVersionStatsDataModel struct {
+	Version    types.String  `tfsdk:"version"`
+	Count      types.Int64   `tfsdk:"count"`
+	Percentage types.Float64 `tfsdk:"percentage"`
+}
+
+func (d *HiveOperationsStatsDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to read the request statistics of a target within a period, in total and per operation and client",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
+				Optional:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
+				Optional:            true,
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "The period up to now to read the statistics of, for example `1h` or `7d`. " +
+					"Defaults to `30d` when `from` isn't set.",
+				Optional: true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The start of the period to read the statistics of (RFC 3339), conflicts with `period`",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The end of the period to read the statistics of (RFC 3339), defaults to now. Requires `from` to be set.",
+				Optional:            true,
+			},
+			"client_names": schema.ListAttribute{
+				MarkdownDescription: "Only include the requests of these clients",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"operation_ids": schema.ListAttribute{
+				MarkdownDescription: "Only include the requests of the operations with these IDs",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"total_requests": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests",
+				Computed:            true,
+			},
+			"total_failures": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests that failed",
+				Computed:            true,
+			},
+			"failure_rate": schema.Float64Attribute{
+				MarkdownDescription: "The percentage of the requests that failed, `0` when there were no requests",
+				Computed:            true,
+			},
+			"total_operations": schema.Int64Attribute{
+				MarkdownDescription: "The number of distinct operations",
+				Computed:            true,
+			},
+			"duration": durationAttribute("The durations of the requests"),
+			"operations": schema.ListNestedAttribute{
+				MarkdownDescription: "The statistics per operation",
+				Computed:            true,
+				NestedObject:        operationStatsObject(),
+			},
+			"clients": schema.ListNestedAttribute{
+				MarkdownDescription: "The statistics per client",
+				Computed:            true,
+				NestedObject:        clientStatsObject(),
+			},
+		},
+	}
+}
+
+func (r *HiveOperationsStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the period.
+func (r *HiveOperationsStatsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data HiveOperationsStatsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, diags := usagePeriod(data.Period, data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HiveOperationsStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveOperationsStatsDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, data.Project, data.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, data.Target, sdk.ScopeRegistryRead, "read operations statistics")...)
+
+	from, to, diags := usagePeriod(data.Period, data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var clientNames, operationIds []string
+	resp.Diagnostics.Append(data.ClientNames.ElementsAs(ctx, &clientNames, false)...)
+	resp.Diagnostics.Append(data.OperationIds.ElementsAs(ctx, &operationIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stats, err := r.client.OperationsStats(ctx, &sdk.OperationsStatsInput{
+		Project:     data.Project.ValueString(),
+		Target:      data.Target.ValueString(),
+		From:        from,
+		To:          to,
+		ClientNames: clientNames,
+		Operations:  operationIds,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading operations statistics failed", err))
+		return
+	}
+
+	data.TotalRequests = types.Int64Value(stats.TotalRequests)
+	data.TotalFailures = types.Int64Value(stats.TotalFailures)
+	data.FailureRate = types.Float64Value(failureRate(stats.TotalRequests, stats.TotalFailures))
+	data.TotalOperations = types.Int64Value(int64(stats.TotalOperations))
+	duration := newDurationDataModel(stats.Duration)
+	data.Duration = &duration
+	data.Operations = newOperationStatsDataModels(stats.Operations)
+	data.Clients = newClientStatsDataModels(stats.Clients)
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// failureRate returns the percentage of the requests that failed.
+func failureRate(requests int64, failures int64) float64 {
+	if requests == 0 {
+		return 0
+	}
+	return float64(failures) / float64(requests) * 100
+}
+
+func durationAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description + ", in milliseconds",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"avg": schema.Int64Attribute{
+				MarkdownDescription: "The average duration",
+				Computed:            true,
+			},
+			"p75": schema.Int64Attribute{
+				MarkdownDescription: "The 75th percentile of the durations",
+				Computed:            true,
+			},
+			"p90": schema.Int64Attribute{
+				MarkdownDescription: "The 90th percentile of the durations",
+				Computed:            true,
+			},
+			"p95": schema.Int64Attribute{
+				MarkdownDescription: "The 95th percentile of the durations",
+				Computed:            true,
+			},
+			"p99": schema.Int64Attribute{
+				MarkdownDescription: "The 99th percentile of the durations",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func operationStatsObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The operation ID, which can be used in `operation_ids`",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The operation name",
+				Computed:            true,
+			},
+			"kind": schema.StringAttribute{
+				MarkdownDescription: "The operation kind, for example `query` or `mutation`",
+				Computed:            true,
+			},
+			"hash": schema.StringAttribute{
+				MarkdownDescription: "The operation hash, if any",
+				Computed:            true,
+			},
+			"count": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests",
+				Computed:            true,
+			},
+			"count_ok": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests that succeeded",
+				Computed:            true,
+			},
+			"failure_rate": schema.Float64Attribute{
+				MarkdownDescription: "The percentage of the requests that failed",
+				Computed:            true,
+			},
+			"percentage": schema.Float64Attribute{
+				MarkdownDescription: "The percentage of all requests",
+				Computed:            true,
+			},
+			"duration": durationAttribute("The durations of the requests"),
+		},
+	}
+}
+
+func clientStatsObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The client name",
+				Computed:            true,
+			},
+			"count": schema.Int64Attribute{
+				MarkdownDescription: "The number of requests",
+				Computed:            true,
+			},
+			"percentage": schema.Float64Attribute{
+				MarkdownDescription: "The percentage of all requests",
+				Computed:            true,
+			},
+			"versions": schema.ListNestedAttribute{
+				MarkdownDescription: "The statistics per client version",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							MarkdownDescription: "The client version",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "The number of requests",
+							Computed:            true,
+						},
+						"percentage": schema.Float64Attribute{
+							MarkdownDescription: "The percentage of the requests of the client",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func newDurationDataModel(duration sdk.DurationStats) HiveDurationDataModel {
+	return HiveDurationDataModel{
+		Avg: types.Int64Value(int64(duration.Avg)),
+		P75: types.Int64Value(int64(duration.P75)),
+		P90: types.Int64Value(int64(duration.P90)),
+		P95: types.Int64Value(int64(duration.P95)),
+		P99: types.Int64Value(int64(duration.P99)),
+	}
+}
+
+func newOperationStatsDataModels(operations []sdk.OperationStats) []HiveOperationStatsDataModel {
+	result := []HiveOperationStatsDataModel{}
+	for _, operation := range operations {
+		result = append(result, HiveOperationStatsDataModel{
+			Id:          types.StringValue(operation.Id),
+			Name:        types.StringValue(operation.Name),
+			Kind:        types.StringValue(operation.Kind),
+			Hash:        types.StringPointerValue(operation.Hash),
+			Count:       types.Int64Value(operation.Count),
+			CountOk:     types.Int64Value(operation.CountOk),
+			FailureRate: types.Float64Value(failureRate(operation.Count, operation.Count-operation.CountOk)),
+			Percentage:  types.Float64Value(operation.Percentage),
+			Duration:    newDurationDataModel(operation.Duration),
+		})
+	}
+	return result
+}
+
+func newClientStatsDataModels(clients []sdk.ClientStats) []HiveClientStatsDataModel {
+	result := []HiveClientStatsDataModel{}
+	for _, client := range clients {
+		versions := []HiveClientVersionStatsDataModel{}
+		for _, version := range client.Versions {
+			versions = append(versions, HiveClientVersionStatsDataModel{
+				Version:    types.StringValue(version.Version),
+				Count:      types.Int64Value(version.Count),
+				Percentage: types.Float64Value(version.Percentage),
+			})
+		}
+
+		result = append(result, HiveClientStatsDataModel{
+			Name:       types.StringValue(client.Name),
+			Count:      types.Int64Value(client.Count),
+			Percentage: types.Float64Value(client.Percentage),
+			Versions:   versions,
+		})
+	}
+	return result
+}
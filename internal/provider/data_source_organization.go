@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveOrganizationDataSource{}
+
+func NewHiveOrganizationDataSource() datasource.DataSource {
+	return &HiveOrganizationDataSource{}
+}
+
+type HiveOrganizationDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveOrganizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+type HiveOrganizationDataSourceModel struct {
+	Organization           types.String `tfsdk:"organization"`
+	Id                     types.String `tfsdk:"id"`
+	Slug                   types.String `tfsdk:"slug"`
+	Plan                   types.String `tfsdk:"plan"`
+	MonthlyOperationsLimit types.Int64  `tfsdk:"monthly_operations_limit"`
+	RetentionInDays        types.Int64  `tfsdk:"retention_in_days"`
+	LimitedForOperations   types.Bool   `tfsdk:"limited_for_operations"`
+}
+
+func (d *HiveOrganizationDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to read an organization, including its plan and usage rate limits",
+
+		Attributes: map[string]schema.Attribute{
+			"organization": schema.StringAttribute{
+				MarkdownDescription: "The organization name, defaults to the `organization` of the provider",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The organization ID",
+				Computed:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The organization slug",
+				Computed:            true,
+			},
+			"plan": schema.StringAttribute{
+				MarkdownDescription: "The billing plan, one of `HOBBY`, `PRO` or `ENTERPRISE`",
+				Computed:            true,
+			},
+			"monthly_operations_limit": schema.Int64Attribute{
+				MarkdownDescription: "The number of operations that are collected per month",
+				Computed:            true,
+			},
+			"retention_in_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days the usage data is kept",
+				Computed:            true,
+			},
+			"limited_for_operations": schema.BoolAttribute{
+				MarkdownDescription: "Whether the organization reached its monthly operations limit",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *HiveOrganizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HiveOrganizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveOrganizationDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := r.client.GetOrganization(ctx, &sdk.GetOrganizationInput{
+		Organization: data.Organization.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading organization failed", err))
+		return
+	}
+
+	data.Id = types.StringValue(organization.Id)
+	data.Slug = types.StringValue(organization.Slug)
+	data.Plan = types.StringValue(organization.Plan)
+	data.MonthlyOperationsLimit = types.Int64Value(organization.MonthlyOperationsLimit)
+	data.RetentionInDays = types.Int64Value(int64(organization.RetentionInDays))
+	data.LimitedForOperations = types.BoolValue(organization.LimitedForOperations)
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveProjectDataSource{}
+
+func NewHiveProjectDataSource() datasource.DataSource {
+	return &HiveProjectDataSource{}
+}
+
+type HiveProjectDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+type HiveProjectDataSourceModel struct {
+	Project       types.String `tfsdk:"project"`
+	Id            types.String `tfsdk:"id"`
+	Slug          types.String `tfsdk:"slug"`
+	Type          types.String `tfsdk:"type"`
+	BuildUrl      types.String `tfsdk:"build_url"`
+	ValidationUrl types.String `tfsdk:"validation_url"`
+}
+
+type HiveProjectDataModel struct {
+	Id            types.String `tfsdk:"id"`
+	Slug          types.String `tfsdk:"slug"`
+	Type          types.String `tfsdk:"type"`
+	BuildUrl      types.String `tfsdk:"build_url"`
+	ValidationUrl types.String `tfsdk:"validation_url"`
+}
+
+// projectDataAttributes returns the attributes describing a project, shared by
+// the hive_project and hive_projects data sources.
+func projectDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The project ID",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "The project slug",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The project type, one of `FEDERATION`, `SINGLE` or `STITCHING`",
+			Computed:            true,
+		},
+		"build_url": schema.StringAttribute{
+			MarkdownDescription: "The URL of the external composition service, if any",
+			Computed:            true,
+		},
+		"validation_url": schema.StringAttribute{
+			MarkdownDescription: "The URL of the external validation service, if any",
+			Computed:            true,
+		},
+	}
+}
+
+func newProjectDataModel(project sdk.Project) HiveProjectDataModel {
+	return HiveProjectDataModel{
+		Id:            types.StringValue(project.Id),
+		Slug:          types.StringValue(project.Slug),
+		Type:          types.StringValue(project.Type),
+		BuildUrl:      types.StringPointerValue(project.BuildUrl),
+		ValidationUrl: types.StringPointerValue(project.ValidationUrl),
+	}
+}
+
+func (d *HiveProjectDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := projectDataAttributes()
+	attributes["project"] = schema.StringAttribute{
+		MarkdownDescription: "The project name, defaults to the `project` of the provider. " +
+			"Requires the `organization` of the provider, when neither is set the project of the token is used.",
+		Optional: true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to read a project",
+		Attributes:          attributes,
+	}
+}
+
+func (r *HiveProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HiveProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveProjectDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateProject(r.client, data.Project)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, types.StringNull(), sdk.ScopeRegistryRead, "read the project")...)
+
+	project, err := r.client.GetProject(ctx, &sdk.GetProjectInput{
+		Project: data.Project.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading project failed", err))
+		return
+	}
+
+	model := newProjectDataModel(*project)
+	data.Id = model.Id
+	data.Slug = model.Slug
+	data.Type = model.Type
+	data.BuildUrl = model.BuildUrl
+	data.ValidationUrl = model.ValidationUrl
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
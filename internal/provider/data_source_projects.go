@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveProjectsDataSource{}
+
+func NewHiveProjectsDataSource() datasource.DataSource {
+	return &HiveProjectsDataSource{}
+}
+
+type HiveProjectsDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+type HiveProjectsDataSourceModel struct {
+	Projects []HiveProjectDataModel `tfsdk:"projects"`
+}
+
+func (d *HiveProjectsDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to list the projects of the organization",
+
+		Attributes: map[string]schema.Attribute{
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The projects of the organization",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: projectDataAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (r *HiveProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HiveProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveProjectsDataSourceModel
+
+	// The projects are listed for the organization of the provider, or of
+	// the token when none is set.
+	resp.Diagnostics.Append(validateTarget(r.client, types.StringNull(), types.StringNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, types.StringNull(), types.StringNull(), sdk.ScopeRegistryRead, "list projects")...)
+
+	projects, err := r.client.ListProjects(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Listing projects failed", err))
+		return
+	}
+
+	data.Projects = []HiveProjectDataModel{}
+	for _, project := range projects {
+		data.Projects = append(data.Projects, newProjectDataModel(project))
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -59,11 +59,11 @@ func (d *HiveSchemaCheckDataSource) Schema(ctx context.Context, _req datasource.
 				Optional:            true,
 			},
 			"project": schema.StringAttribute{
-				MarkdownDescription: "The project name",
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
 				Optional:            true,
 			},
 			"target": schema.StringAttribute{
-				MarkdownDescription: "The target name",
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
 				Optional:            true,
 			},
 			"id": schema.StringAttribute{
@@ -104,6 +104,12 @@ func (r *HiveSchemaCheckDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
+	resp.Diagnostics.Append(validateTarget(r.client, data.Project, data.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, data.Target, sdk.ScopeRegistryRead, "check schemas")...)
+
 	result, err := r.client.SchemaCheck(ctx, &sdk.SchemaCheckInput{
 		Service:   data.Service.ValueString(),
 		Schema:    data.Schema.ValueString(),
@@ -115,10 +121,7 @@ func (r *HiveSchemaCheckDataSource) Read(ctx context.Context, req datasource.Rea
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Schema check failed",
-			fmt.Sprintf("Unable validate schema, got error: %s", err.Error()),
-		)
+		resp.Diagnostics.Append(newErrorDiagnostic("Schema check failed", err))
 		return
 	}
 
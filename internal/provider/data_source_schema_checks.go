@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveSchemaChecksDataSource{}
+
+func NewHiveSchemaChecksDataSource() datasource.DataSource {
+	return &HiveSchemaChecksDataSource{}
+}
+
+type HiveSchemaChecksDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveSchemaChecksDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_checks"
+}
+
+type HiveSchemaChecksDataSourceModel struct {
+	Project      types.String               `tfsdk:"project"`
+	Target       types.String               `tfsdk:"target"`
+	Failed       types.Bool                 `tfsdk:"failed"`
+	Changed      types.Bool                 `tfsdk:"changed"`
+	After        types.String               `tfsdk:"after"`
+	Limit        types.Int64                `tfsdk:"limit"`
+	Id           types.String               `tfsdk:"id"`
+	EndCursor    types.String               `tfsdk:"end_cursor"`
+	SchemaChecks []HiveSchemaCheckDataModel `tfsdk:"schema_checks"`
+}
+
+type HiveSchemaCheckDataModel struct {
+	Id                           types.String `tfsdk:"id"`
+	CreatedAt                    types.String `tfsdk:"created_at"`
+	Service                      types.String `tfsdk:"service"`
+	Author                       types.String `tfsdk:"author"`
+	Commit                       types.String `tfsdk:"commit"`
+	ContextId                    types.String `tfsdk:"context_id"`
+	URL                          types.String `tfsdk:"url"`
+	Valid                        types.Bool   `tfsdk:"valid"`
+	HasSchemaChanges             types.Bool   `tfsdk:"has_schema_changes"`
+	HasUnapprovedBreakingChanges types.Bool   `tfsdk:"has_unapproved_breaking_changes"`
+	HasCompositionErrors         types.Bool   `tfsdk:"has_composition_errors"`
+	Approved                     types.Bool   `tfsdk:"approved"`
+	ApprovedBy                   types.String `tfsdk:"approved_by"`
+	ApprovalComment              types.String `tfsdk:"approval_comment"`
+}
+
+func (d *HiveSchemaChecksDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to list the recent schema checks of a target, newest first",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
+				Optional:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
+				Optional:            true,
+			},
+			"failed": schema.BoolAttribute{
+				MarkdownDescription: "Only return the failed schema checks when `true`, or only the successful ones when `false`",
+				Optional:            true,
+			},
+			"changed": schema.BoolAttribute{
+				MarkdownDescription: "Only return the schema checks with schema changes when `true`, or only the ones without when `false`",
+				Optional:            true,
+			},
+			"after": schema.StringAttribute{
+				MarkdownDescription: "Continue listing after this cursor, use the `end_cursor` of a previous read",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of schema checks to return, defaults to `50`",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The target ID",
+			},
+			"end_cursor": schema.StringAttribute{
+				MarkdownDescription: "The cursor to pass as `after` to list the next schema checks, null when there are no more schema checks",
+				Computed:            true,
+			},
+			"schema_checks": schema.ListNestedAttribute{
+				MarkdownDescription: "The matching schema checks",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The schema check ID",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "When the schema check was performed",
+							Computed:            true,
+						},
+						"service": schema.StringAttribute{
+							MarkdownDescription: "The service name, null for single schema projects",
+							Computed:            true,
+						},
+						"author": schema.StringAttribute{
+							MarkdownDescription: "The author of the schema check, if provided",
+							Computed:            true,
+						},
+						"commit": schema.StringAttribute{
+							MarkdownDescription: "The commit of the schema check, if provided",
+							Computed:            true,
+						},
+						"context_id": schema.StringAttribute{
+							MarkdownDescription: "The context ID of the schema check, if provided",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The link to the schema check in the Hive dashboard",
+							Computed:            true,
+						},
+						"valid": schema.BoolAttribute{
+							MarkdownDescription: "Whether the schema check succeeded",
+							Computed:            true,
+						},
+						"has_schema_changes": schema.BoolAttribute{
+							MarkdownDescription: "Whether the schema check contains schema changes",
+							Computed:            true,
+						},
+						"has_unapproved_breaking_changes": schema.BoolAttribute{
+							MarkdownDescription: "Whether the schema check contains breaking changes that weren't approved",
+							Computed:            true,
+						},
+						"has_composition_errors": schema.BoolAttribute{
+							MarkdownDescription: "Whether the schema check failed to compose",
+							Computed:            true,
+						},
+						"approved": schema.BoolAttribute{
+							MarkdownDescription: "Whether the breaking changes of the schema check were manually approved",
+							Computed:            true,
+						},
+						"approved_by": schema.StringAttribute{
+							MarkdownDescription: "The name of the user that approved the schema check, if any",
+							Computed:            true,
+						},
+						"approval_comment": schema.StringAttribute{
+							MarkdownDescription: "The comment left when approving the schema check, if any",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *HiveSchemaChecksDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HiveSchemaChecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveSchemaChecksDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, data.Project, data.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, data.Target, sdk.ScopeRegistryRead, "list schema checks")...)
+
+	limit := int64(50)
+	if !data.Limit.IsNull() {
+		limit = data.Limit.ValueInt64()
+		if limit < 1 {
+			resp.Diagnostics.AddAttributeError(path.Root("limit"), "Invalid limit", "The limit must be at least 1.")
+			return
+		}
+	}
+
+	result, err := r.client.ListSchemaChecks(ctx, &sdk.ListSchemaChecksInput{
+		Project: data.Project.ValueString(),
+		Target:  data.Target.ValueString(),
+		Failed:  data.Failed.ValueBoolPointer(),
+		Changed: data.Changed.ValueBoolPointer(),
+		After:   data.After.ValueString(),
+		Limit:   int(limit),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Listing schema checks failed", err))
+		return
+	}
+
+	data.Id = types.StringValue(result.TargetId)
+	data.EndCursor = types.StringNull()
+	if result.EndCursor != "" {
+		data.EndCursor = types.StringValue(result.EndCursor)
+	}
+
+	data.SchemaChecks = []HiveSchemaCheckDataModel{}
+	for _, check := range result.SchemaChecks {
+		data.SchemaChecks = append(data.SchemaChecks, HiveSchemaCheckDataModel{
+			Id:                           types.StringValue(check.Id),
+			CreatedAt:                    types.StringValue(check.CreatedAt),
+			Service:                      types.StringPointerValue(check.Service),
+			Author:                       types.StringPointerValue(check.Author),
+			Commit:                       types.StringPointerValue(check.Commit),
+			ContextId:                    types.StringPointerValue(check.ContextId),
+			URL:                          types.StringPointerValue(check.URL),
+			Valid:                        types.BoolValue(check.Valid),
+			HasSchemaChanges:             types.BoolValue(check.HasSchemaChanges),
+			HasUnapprovedBreakingChanges: types.BoolValue(check.HasUnapprovedBreakingChanges),
+			HasCompositionErrors:         types.BoolValue(check.HasSchemaCompositionErrors),
+			Approved:                     types.BoolValue(check.Approved),
+			ApprovedBy:                   types.StringPointerValue(check.ApprovedBy),
+			ApprovalComment:              types.StringPointerValue(check.ApprovalComment),
+		})
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveSchemaCoordinateUsageDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &HiveSchemaCoordinateUsageDataSource{}
+
+func NewHiveSchemaCoordinateUsageDataSource() datasource.DataSource {
+	return &HiveSchemaCoordinateUsageDataSource{}
+}
+
+type HiveSchemaCoordinateUsageDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveSchemaCoordinateUsageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_coordinate_usage"
+}
+
+type HiveSchemaCoordinateUsageDataSourceModel struct {
+	Project     types.String                   `tfsdk:"project"`
+	Target      types.String                   `tfsdk:"target"`
+	Coordinates types.List                     `tfsdk:"coordinates"`
+	Period      types.String                   `tfsdk:"period"`
+	From        types.String                   `tfsdk:"from"`
+	To          types.String                   `tfsdk:"to"`
+	ClientLimit types.Int64                    `tfsdk:"client_limit"`
+	Usage       []HiveCoordinateUsageDataModel `tfsdk:"usage"`
+}
+
+type HiveCoordinateUsageDataModel struct {
+	Coordinate types.String               `tfsdk:"coordinate"`
+	Count      types.Int64                `tfsdk:"count"`
+	Percentage types.Float64              `tfsdk:"percentage"`
+	IsUsed     types.Bool                 `tfsdk:"is_used"`
+	Clients    []HiveClientUsageDataModel `tfsdk:"clients"`
+}
+
+type HiveClientUsageDataModel struct {
+	Name       types.String  `tfsdk:"name"`
+	Count      types.Int64   `tfsdk:"count"`
+	Percentage types.Float64 `tfsdk:"percentage"`
+}
+
+func (d *HiveSchemaCoordinateUsageDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to read how often schema coordinates were requested, and by which clients. " +
+			"Useful to check that a field is unused before removing it, for example in a precondition.",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
+				Optional:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
+				Optional:            true,
+			},
+			"coordinates": schema.ListAttribute{
+				MarkdownDescription: "The schema coordinates, either `Type.field` or `Type.field.argument`. " +
+					"Each of them must exist in the latest valid schema version of the target.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "The period up to now to read the usage of, for example `720h` or `30d`. " +
+					"Defaults to `30d` when `from` isn't set.",
+				Optional: true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The start of the period to read the usage of (RFC 3339), conflicts with `period`",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The end of the period to read the usage of (RFC 3339), defaults to now. Requires `from` to be set.",
+				Optional:            true,
+			},
+			"client_limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of clients to return per coordinate, defaults to `5`. " +
+					"Set to `0` to return all clients.",
+				Optional: true,
+			},
+			"usage": schema.ListNestedAttribute{
+				MarkdownDescription: "The usage of each of the coordinates, in the same order",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"coordinate": schema.StringAttribute{
+							MarkdownDescription: "The schema coordinate",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "The number of requests that used the coordinate",
+							Computed:            true,
+						},
+						"percentage": schema.Float64Attribute{
+							MarkdownDescription: "The percentage of all requests that used the coordinate",
+							Computed:            true,
+						},
+						"is_used": schema.BoolAttribute{
+							MarkdownDescription: "Whether the coordinate was used within the period",
+							Computed:            true,
+						},
+						"clients": schema.ListNestedAttribute{
+							MarkdownDescription: "The clients that used the coordinate the most",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "The client name",
+										Computed:            true,
+									},
+									"count": schema.Int64Attribute{
+										MarkdownDescription: "The number of requests of the client that used the coordinate",
+										Computed:            true,
+									},
+									"percentage": schema.Float64Attribute{
+										MarkdownDescription: "The percentage of the requests that used the coordinate coming from the client",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *HiveSchemaCoordinateUsageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the coordinates and the period.
+func (r *HiveSchemaCoordinateUsageDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data HiveSchemaCoordinateUsageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, element := range data.Coordinates.Elements() {
+		coordinate, ok := element.(types.String)
+		if !ok || coordinate.IsNull() || coordinate.IsUnknown() {
+			continue
+		}
+		if _, err := sdk.ParseSchemaCoordinate(coordinate.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("coordinates").AtListIndex(i), "Invalid schema coordinate", err.Error())
+		}
+	}
+
+	_, _, diags := usagePeriod(data.Period, data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HiveSchemaCoordinateUsageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveSchemaCoordinateUsageDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, data.Project, data.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, data.Target, sdk.ScopeRegistryRead, "read schema coordinate usage")...)
+
+	from, to, diags := usagePeriod(data.Period, data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientLimit := int64(5)
+	if !data.ClientLimit.IsNull() {
+		clientLimit = data.ClientLimit.ValueInt64()
+		if clientLimit < 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("client_limit"), "Invalid client limit", "The client limit can't be negative.")
+			return
+		}
+	}
+
+	coordinates := []string{}
+	resp.Diagnostics.Append(data.Coordinates.ElementsAs(ctx, &coordinates, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usage, err := r.client.SchemaCoordinateUsage(ctx, &sdk.SchemaCoordinateUsageInput{
+		Project:     data.Project.ValueString(),
+		Target:      data.Target.ValueString(),
+		Coordinates: coordinates,
+		From:        from,
+		To:          to,
+		ClientLimit: int(clientLimit),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading schema coordinate usage failed", err))
+		return
+	}
+
+	data.Usage = []HiveCoordinateUsageDataModel{}
+	for _, u := range usage {
+		clients := []HiveClientUsageDataModel{}
+		for _, c := range u.Clients {
+			clients = append(clients, HiveClientUsageDataModel{
+				Name:       types.StringValue(c.Name),
+				Count:      types.Int64Value(c.Count),
+				Percentage: types.Float64Value(c.Percentage),
+			})
+		}
+
+		data.Usage = append(data.Usage, HiveCoordinateUsageDataModel{
+			Coordinate: types.StringValue(u.Coordinate),
+			Count:      types.Int64Value(u.Count),
+			Percentage: types.Float64Value(u.Percentage),
+			IsUsed:     types.BoolValue(u.Count > 0),
+			Clients:    clients,
+		})
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveSchemaPolicyRulesDataSource{}
+
+func NewHiveSchemaPolicyRulesDataSource() datasource.DataSource {
+	return &HiveSchemaPolicyRulesDataSource{}
+}
+
+type HiveSchemaPolicyRulesDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveSchemaPolicyRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_policy_rules"
+}
+
+type HiveSchemaPolicyRulesDataSourceModel struct {
+	Rules []HiveSchemaPolicyRuleDataModel `tfsdk:"rules"`
+}
+
+type HiveSchemaPolicyRuleDataModel struct {
+	Id               types.String `tfsdk:"id"`
+	Description      types.String `tfsdk:"description"`
+	DocumentationUrl types.String `tfsdk:"documentation_url"`
+	Recommended      types.Bool   `tfsdk:"recommended"`
+	ConfigJSONSchema types.String `tfsdk:"config_json_schema"`
+}
+
+func (d *HiveSchemaPolicyRulesDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to list the rules that are available for schema policies",
+
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The available rules",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The rule ID",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the rule",
+							Computed:            true,
+						},
+						"documentation_url": schema.StringAttribute{
+							MarkdownDescription: "The link to the documentation of the rule, if any",
+							Computed:            true,
+						},
+						"recommended": schema.BoolAttribute{
+							MarkdownDescription: "Whether the rule is recommended",
+							Computed:            true,
+						},
+						"config_json_schema": schema.StringAttribute{
+							MarkdownDescription: "The JSON schema of the rule configuration, null when the rule can't be configured",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *HiveSchemaPolicyRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HiveSchemaPolicyRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveSchemaPolicyRulesDataSourceModel
+
+	rules, err := r.client.SchemaPolicyRules(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Listing schema policy rules failed", err))
+		return
+	}
+
+	data.Rules = []HiveSchemaPolicyRuleDataModel{}
+	for _, rule := range rules {
+		data.Rules = append(data.Rules, HiveSchemaPolicyRuleDataModel{
+			Id:               types.StringValue(rule.Id),
+			Description:      types.StringValue(rule.Description),
+			DocumentationUrl: types.StringPointerValue(rule.DocumentationUrl),
+			Recommended:      types.BoolValue(rule.Recommended),
+			ConfigJSONSchema: types.StringPointerValue(rule.ConfigJSONSchema),
+		})
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
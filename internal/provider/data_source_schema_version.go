@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveSchemaVersionDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &HiveSchemaVersionDataSource{}
+
+func NewHiveSchemaVersionDataSource() datasource.DataSource {
+	return &HiveSchemaVersionDataSource{}
+}
+
+type HiveSchemaVersionDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveSchemaVersionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schema_version"
+}
+
+type HiveSchemaVersionDataSourceModel struct {
+	Project      types.String                 `tfsdk:"project"`
+	Target       types.String                 `tfsdk:"target"`
+	VersionId    types.String                 `tfsdk:"version_id"`
+	ActionId     types.String                 `tfsdk:"action_id"`
+	OnlyValid    types.Bool                   `tfsdk:"only_valid"`
+	Id           types.String                 `tfsdk:"id"`
+	Date         types.String                 `tfsdk:"date"`
+	Valid        types.Bool                   `tfsdk:"valid"`
+	IsComposable types.Bool                   `tfsdk:"is_composable"`
+	SDL          types.String                 `tfsdk:"sdl"`
+	Supergraph   types.String                 `tfsdk:"supergraph"`
+	Tags         []types.String               `tfsdk:"tags"`
+	Schemas      []HiveServiceSchemaDataModel `tfsdk:"schemas"`
+}
+
+type HiveServiceSchemaDataModel struct {
+	Id      types.String `tfsdk:"id"`
+	Service types.String `tfsdk:"service"`
+	SDL     types.String `tfsdk:"sdl"`
+	URL     types.String `tfsdk:"url"`
+}
+
+func (d *HiveSchemaVersionDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to read a schema version of a target. By default the latest valid schema version is returned, " +
+			"use `version_id` or `action_id` to select a specific schema version instead.",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
+				Optional:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
+				Optional:            true,
+			},
+			"version_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the schema version to return",
+				Optional:            true,
+			},
+			"action_id": schema.StringAttribute{
+				MarkdownDescription: "Return the schema version created by the schema publish with this action ID",
+				Optional:            true,
+			},
+			"only_valid": schema.BoolAttribute{
+				MarkdownDescription: "Return the latest valid schema version, instead of the latest schema version. " +
+					"Only applies when neither `version_id` nor `action_id` is set. Defaults to `true`.",
+				Optional: true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The schema version ID",
+				Computed:            true,
+			},
+			"date": schema.StringAttribute{
+				MarkdownDescription: "When the schema version was created (RFC 3339)",
+				Computed:            true,
+			},
+			"valid": schema.BoolAttribute{
+				MarkdownDescription: "Whether the composition and contract compositions of the schema version succeeded",
+				Computed:            true,
+			},
+			"is_composable": schema.BoolAttribute{
+				MarkdownDescription: "Whether the schema version is composable",
+				Computed:            true,
+			},
+			"sdl": schema.StringAttribute{
+				MarkdownDescription: "The composed public schema, null when the schema version isn't composable",
+				Computed:            true,
+			},
+			"supergraph": schema.StringAttribute{
+				MarkdownDescription: "The supergraph, null when the project doesn't use Federation or the schema version isn't composable",
+				Computed:            true,
+			},
+			"tags": schema.ListAttribute{
+				MarkdownDescription: "The tags used in the schema version, for example with Federation",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"schemas": schema.ListNestedAttribute{
+				MarkdownDescription: "The schemas of the services in the schema version",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The schema ID",
+							Computed:            true,
+						},
+						"service": schema.StringAttribute{
+							MarkdownDescription: "The service name, null for single schema projects",
+							Computed:            true,
+						},
+						"sdl": schema.StringAttribute{
+							MarkdownDescription: "The schema of the service",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the service, if any",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *HiveSchemaVersionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks that at most one schema version is selected.
+func (r *HiveSchemaVersionDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data HiveSchemaVersionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.VersionId.IsNull() && !data.ActionId.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("action_id"),
+			"Conflicting schema version selectors",
+			"Only one of version_id and action_id can be set.",
+		)
+	}
+}
+
+func (r *HiveSchemaVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveSchemaVersionDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, data.Project, data.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, data.Target, sdk.ScopeRegistryRead, "read schema versions")...)
+
+	version, err := r.client.SchemaVersion(ctx, &sdk.SchemaVersionInput{
+		Project:  data.Project.ValueString(),
+		Target:   data.Target.ValueString(),
+		Id:       data.VersionId.ValueString(),
+		ActionId: data.ActionId.ValueString(),
+		Valid:    data.OnlyValid.IsNull() || data.OnlyValid.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading schema version failed", err))
+		return
+	}
+
+	if version == nil {
+		resp.Diagnostics.AddError("Schema version not found", "The target doesn't have a matching schema version.")
+		return
+	}
+
+	data.Id = types.StringValue(version.Id)
+	data.Date = types.StringValue(version.Date.Format(time.RFC3339))
+	data.Valid = types.BoolValue(version.Valid)
+	data.IsComposable = types.BoolValue(version.IsComposable)
+	data.SDL = types.StringPointerValue(version.SDL)
+	data.Supergraph = types.StringPointerValue(version.Supergraph)
+
+	data.Tags = []types.String{}
+	for _, tag := range version.Tags {
+		data.Tags = append(data.Tags, types.StringValue(tag))
+	}
+
+	data.Schemas = []HiveServiceSchemaDataModel{}
+	for _, s := range version.Schemas {
+		data.Schemas = append(data.Schemas, HiveServiceSchemaDataModel{
+			Id:      types.StringValue(s.Id),
+			Service: types.StringPointerValue(s.Service),
+			SDL:     types.StringValue(s.SDL),
+			URL:     types.StringPointerValue(s.URL),
+		})
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveTargetDataSource{}
+
+func NewHiveTargetDataSource() datasource.DataSource {
+	return &HiveTargetDataSource{}
+}
+
+type HiveTargetDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveTargetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_target"
+}
+
+type HiveTargetDataSourceModel struct {
+	Project            types.String                    `tfsdk:"project"`
+	Target             types.String                    `tfsdk:"target"`
+	Id                 types.String                    `tfsdk:"id"`
+	Slug               types.String                    `tfsdk:"slug"`
+	CdnUrl             types.String                    `tfsdk:"cdn_url"`
+	GraphqlEndpointUrl types.String                    `tfsdk:"graphql_endpoint_url"`
+	ValidationSettings HiveValidationSettingsDataModel `tfsdk:"validation_settings"`
+}
+
+type HiveTargetDataModel struct {
+	Id                 types.String                    `tfsdk:"id"`
+	Slug               types.String                    `tfsdk:"slug"`
+	CdnUrl             types.String                    `tfsdk:"cdn_url"`
+	GraphqlEndpointUrl types.String                    `tfsdk:"graphql_endpoint_url"`
+	ValidationSettings HiveValidationSettingsDataModel `tfsdk:"validation_settings"`
+}
+
+type HiveValidationSettingsDataModel struct {
+	Enabled               types.Bool     `tfsdk:"enabled"`
+	BreakingChangeFormula types.String   `tfsdk:"breaking_change_formula"`
+	Percentage            types.Float64  `tfsdk:"percentage"`
+	Period                types.Int64    `tfsdk:"period"`
+	RequestCount          types.Int64    `tfsdk:"request_count"`
+	ExcludedClients       []types.String `tfsdk:"excluded_clients"`
+	Targets               []types.String `tfsdk:"targets"`
+}
+
+// targetDataAttributes returns the attributes describing a target, shared by
+// the hive_target and hive_targets data sources.
+func targetDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: "The target ID",
+			Computed:            true,
+		},
+		"slug": schema.StringAttribute{
+			MarkdownDescription: "The target slug",
+			Computed:            true,
+		},
+		"cdn_url": schema.StringAttribute{
+			MarkdownDescription: "The URL for accessing the artifacts of the target via the CDN",
+			Computed:            true,
+		},
+		"graphql_endpoint_url": schema.StringAttribute{
+			MarkdownDescription: "The GraphQL endpoint URL used by the explorer of the target, if any",
+			Computed:            true,
+		},
+		"validation_settings": schema.SingleNestedAttribute{
+			MarkdownDescription: "The settings used to decide whether a schema change is breaking based on the usage data",
+			Computed:            true,
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					MarkdownDescription: "Whether the usage data is used to decide whether a schema change is breaking",
+					Computed:            true,
+				},
+				"breaking_change_formula": schema.StringAttribute{
+					MarkdownDescription: "The formula used to decide whether a change is breaking, either `PERCENTAGE` or `REQUEST_COUNT`",
+					Computed:            true,
+				},
+				"percentage": schema.Float64Attribute{
+					MarkdownDescription: "The percentage of the operations within the period that have to use a changed schema coordinate " +
+						"for the change to be breaking, with the `PERCENTAGE` formula",
+					Computed: true,
+				},
+				"period": schema.Int64Attribute{
+					MarkdownDescription: "The number of days of usage data that is considered",
+					Computed:            true,
+				},
+				"request_count": schema.Int64Attribute{
+					MarkdownDescription: "The number of operations within the period that have to use a changed schema coordinate " +
+						"for the change to be breaking, with the `REQUEST_COUNT` formula",
+					Computed: true,
+				},
+				"excluded_clients": schema.ListAttribute{
+					MarkdownDescription: "The clients whose usage is ignored",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+				"targets": schema.ListAttribute{
+					MarkdownDescription: "The slugs of the targets whose usage data is considered",
+					ElementType:         types.StringType,
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func newTargetDataModel(target sdk.Target) HiveTargetDataModel {
+	settings := target.ValidationSettings
+
+	result := HiveTargetDataModel{
+		Id:                 types.StringValue(target.Id),
+		Slug:               types.StringValue(target.Slug),
+		CdnUrl:             types.StringValue(target.CdnUrl),
+		GraphqlEndpointUrl: types.StringPointerValue(target.GraphqlEndpointUrl),
+		ValidationSettings: HiveValidationSettingsDataModel{
+			Enabled:               types.BoolValue(settings.Enabled),
+			BreakingChangeFormula: types.StringValue(settings.BreakingChangeFormula),
+			Percentage:            types.Float64Value(settings.Percentage),
+			Period:                types.Int64Value(int64(settings.Period)),
+			RequestCount:          types.Int64Value(int64(settings.RequestCount)),
+			ExcludedClients:       []types.String{},
+			Targets:               []types.String{},
+		},
+	}
+
+	for _, name := range settings.ExcludedClients {
+		result.ValidationSettings.ExcludedClients = append(result.ValidationSettings.ExcludedClients, types.StringValue(name))
+	}
+	for _, slug := range settings.Targets {
+		result.ValidationSettings.Targets = append(result.ValidationSettings.Targets, types.StringValue(slug))
+	}
+
+	return result
+}
+
+func (d *HiveTargetDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := targetDataAttributes()
+	attributes["project"] = schema.StringAttribute{
+		MarkdownDescription: "The project name, defaults to the `project` of the provider",
+		Optional:            true,
+	}
+	attributes["target"] = schema.StringAttribute{
+		MarkdownDescription: "The target name, defaults to the `target` of the provider",
+		Optional:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to read a target, including its CDN URL and validation settings",
+		Attributes:          attributes,
+	}
+}
+
+func (r *HiveTargetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HiveTargetDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveTargetDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, data.Project, data.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, data.Target, sdk.ScopeRegistryRead, "read the target")...)
+
+	target, err := r.client.GetTarget(ctx, &sdk.GetTargetInput{
+		Project: data.Project.ValueString(),
+		Target:  data.Target.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading target failed", err))
+		return
+	}
+
+	model := newTargetDataModel(*target)
+	data.Id = model.Id
+	data.Slug = model.Slug
+	data.CdnUrl = model.CdnUrl
+	data.GraphqlEndpointUrl = model.GraphqlEndpointUrl
+	data.ValidationSettings = model.ValidationSettings
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveTargetsDataSource{}
+
+func NewHiveTargetsDataSource() datasource.DataSource {
+	return &HiveTargetsDataSource{}
+}
+
+type HiveTargetsDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveTargetsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_targets"
+}
+
+type HiveTargetsDataSourceModel struct {
+	Project types.String          `tfsdk:"project"`
+	Targets []HiveTargetDataModel `tfsdk:"targets"`
+}
+
+func (d *HiveTargetsDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to list the targets of a project",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider. " +
+					"Requires the `organization` of the provider, when neither is set the project of the token is used.",
+				Optional: true,
+			},
+			"targets": schema.ListNestedAttribute{
+				MarkdownDescription: "The targets of the project",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: targetDataAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (r *HiveTargetsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *HiveTargetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveTargetsDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateProject(r.client, data.Project)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, types.StringNull(), sdk.ScopeRegistryRead, "list targets")...)
+
+	targets, err := r.client.ListTargets(ctx, &sdk.ListTargetsInput{
+		Project: data.Project.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Listing targets failed", err))
+		return
+	}
+
+	data.Targets = []HiveTargetDataModel{}
+	for _, target := range targets {
+		data.Targets = append(data.Targets, newTargetDataModel(target))
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+var _ datasource.DataSource = &HiveUnusedSchemaDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &HiveUnusedSchemaDataSource{}
+
+func NewHiveUnusedSchemaDataSource() datasource.DataSource {
+	return &HiveUnusedSchemaDataSource{}
+}
+
+type HiveUnusedSchemaDataSource struct {
+	client *sdk.HiveClient
+}
+
+func (r *HiveUnusedSchemaDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unused_schema"
+}
+
+type HiveUnusedSchemaDataSourceModel struct {
+	Project      types.String                        `tfsdk:"project"`
+	Target       types.String                        `tfsdk:"target"`
+	Period       types.String                        `tfsdk:"period"`
+	From         types.String                        `tfsdk:"from"`
+	To           types.String                        `tfsdk:"to"`
+	Id           types.String                        `tfsdk:"id"`
+	UnusedTypes  []types.String                      `tfsdk:"unused_types"`
+	UnusedFields []types.String                      `tfsdk:"unused_fields"`
+	Deprecated   []HiveDeprecatedCoordinateDataModel `tfsdk:"deprecated"`
+}
+
+type HiveDeprecatedCoordinateDataModel struct {
+	Coordinate types.String   `tfsdk:"coordinate"`
+	Reason     types.String   `tfsdk:"reason"`
+	IsUsed     types.Bool     `tfsdk:"is_used"`
+	Count      types.Int64    `tfsdk:"count"`
+	Clients    []types.String `tfsdk:"clients"`
+}
+
+func (d *HiveUnusedSchemaDataSource) Schema(ctx context.Context, _req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Data source to list the types and fields of the latest valid schema version of a target that weren't used within a period, " +
+			"and the usage of its deprecated fields.",
+
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
+				Optional:            true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
+				Optional:            true,
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "The period up to now to read the usage of, for example `720h` or `30d`. " +
+					"Defaults to `30d` when `from` isn't set.",
+				Optional: true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "The start of the period to read the usage of (RFC 3339), conflicts with `period`",
+				Optional:            true,
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "The end of the period to read the usage of (RFC 3339), defaults to now. Requires `from` to be set.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the schema version the usage is based on",
+				Computed:            true,
+			},
+			"unused_types": schema.ListAttribute{
+				MarkdownDescription: "The names of the types that weren't used",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"unused_fields": schema.ListAttribute{
+				MarkdownDescription: "The coordinates of the fields, arguments, input fields and enum values that weren't used, " +
+					"for example `Query.products` or `Query.products.filter`",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"deprecated": schema.ListNestedAttribute{
+				MarkdownDescription: "The deprecated fields, arguments, input fields and enum values, whether used or not",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"coordinate": schema.StringAttribute{
+							MarkdownDescription: "The schema coordinate",
+							Computed:            true,
+						},
+						"reason": schema.StringAttribute{
+							MarkdownDescription: "The deprecation reason, if any",
+							Computed:            true,
+						},
+						"is_used": schema.BoolAttribute{
+							MarkdownDescription: "Whether the coordinate was used within the period",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "The number of requests that used the coordinate",
+							Computed:            true,
+						},
+						"clients": schema.ListAttribute{
+							MarkdownDescription: "The names of the clients that used the coordinate",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *HiveUnusedSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the period.
+func (r *HiveUnusedSchemaDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data HiveUnusedSchemaDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, diags := usagePeriod(data.Period, data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *HiveUnusedSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data HiveUnusedSchemaDataSourceModel
+
+	diags := req.Config.Get(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, data.Project, data.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(checkScope(r.client, data.Project, data.Target, sdk.ScopeRegistryRead, "read unused schema")...)
+
+	from, to, diags := usagePeriod(data.Period, data.From, data.To)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	unused, err := r.client.UnusedSchema(ctx, &sdk.UnusedSchemaInput{
+		Project: data.Project.ValueString(),
+		Target:  data.Target.ValueString(),
+		From:    from,
+		To:      to,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading unused schema failed", err))
+		return
+	}
+
+	if unused == nil {
+		resp.Diagnostics.AddError("Schema version not found", "The target doesn't have a valid schema version.")
+		return
+	}
+
+	data.Id = types.StringValue(unused.SchemaVersionId)
+
+	data.UnusedTypes = []types.String{}
+	for _, name := range unused.Types {
+		data.UnusedTypes = append(data.UnusedTypes, types.StringValue(name))
+	}
+
+	data.UnusedFields = []types.String{}
+	for _, coordinate := range unused.Coordinates {
+		data.UnusedFields = append(data.UnusedFields, types.StringValue(coordinate))
+	}
+
+	data.Deprecated = []HiveDeprecatedCoordinateDataModel{}
+	for _, d := range unused.Deprecated {
+		clients := []types.String{}
+		for _, name := range d.Clients {
+			clients = append(clients, types.StringValue(name))
+		}
+
+		data.Deprecated = append(data.Deprecated, HiveDeprecatedCoordinateDataModel{
+			Coordinate: types.StringValue(d.Coordinate),
+			Reason:     types.StringPointerValue(d.Reason),
+			IsUsed:     types.BoolValue(d.IsUsed),
+			Count:      types.Int64Value(d.Count),
+			Clients:    clients,
+		})
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ComposeSupergraphFunction{}
+
+// NewComposeSupergraphFunction is a helper function to simplify the provider implementation.
+func NewComposeSupergraphFunction() function.Function {
+	return &ComposeSupergraphFunction{}
+}
+
+// ComposeSupergraphFunction defines the function implementation.
+type ComposeSupergraphFunction struct{}
+
+// SubgraphModel describes a subgraph passed to the function.
+type SubgraphModel struct {
+	SDL types.String `tfsdk:"sdl"`
+	URL types.String `tfsdk:"url"`
+}
+
+// CompositionResultModel describes the result of the function.
+type CompositionResultModel struct {
+	Supergraph types.String            `tfsdk:"supergraph"`
+	Errors     []CompositionErrorModel `tfsdk:"errors"`
+}
+
+// CompositionErrorModel describes a single composition error.
+type CompositionErrorModel struct {
+	Code    types.String `tfsdk:"code"`
+	Message types.String `tfsdk:"message"`
+}
+
+var compositionErrorAttrTypes = map[string]attr.Type{
+	"code":    types.StringType,
+	"message": types.StringType,
+}
+
+// Metadata returns the function name.
+func (f *ComposeSupergraphFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "compose_supergraph"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *ComposeSupergraphFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compose subgraphs into a supergraph (experimental)",
+		MarkdownDescription: "**Experimental:** the composition is a partial implementation of Federation v2, " +
+			"its results may differ from those of Hive and may change in future releases.\n\n" +
+			"Composes the subgraphs into a Federation v2 supergraph, without contacting Hive. " +
+			"Returns an object with the `supergraph` SDL, or `null` when the subgraphs don't compose, " +
+			"and the composition `errors`, each with a `code` and `message`.\n\n" +
+			"The composition only supports a subset of Federation v2: entities, shareable and external fields, " +
+			"`@requires`, `@provides`, and the merging rules for fields, arguments, input objects, enums and unions. " +
+			"Subgraphs using `@override`, `@inaccessible`, `@tag`, `@interfaceObject`, `@composeDirective`, " +
+			"`@authenticated`, `@requiresScopes`, `@policy`, `@context`, `@fromContext`, `@cost` or `@listSize` " +
+			"fail with the `UNSUPPORTED_FEATURE` error code, and custom directives other than `@deprecated` and " +
+			"`@specifiedBy` aren't carried over to the supergraph. " +
+			"Use it to catch composition errors early, for example in module tests; " +
+			"the composition of Hive remains the authority when publishing.",
+
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:                "subgraphs",
+				MarkdownDescription: "The subgraphs by service name, each with the `sdl` and the `url` of the service",
+				ElementType: types.ObjectType{AttrTypes: map[string]attr.Type{
+					"sdl": types.StringType,
+					"url": types.StringType,
+				}},
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: map[string]attr.Type{
+				"supergraph": types.StringType,
+				"errors":     types.ListType{ElemType: types.ObjectType{AttrTypes: compositionErrorAttrTypes}},
+			},
+		},
+	}
+}
+
+// Run composes the subgraphs.
+func (f *ComposeSupergraphFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var subgraphs map[string]SubgraphModel
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &subgraphs))
+	if resp.Error != nil {
+		return
+	}
+
+	input := make([]sdk.Subgraph, 0, len(subgraphs))
+	for name, subgraph := range subgraphs {
+		input = append(input, sdk.Subgraph{
+			Name: name,
+			URL:  subgraph.URL.ValueString(),
+			SDL:  subgraph.SDL.ValueString(),
+		})
+	}
+
+	supergraph, errs := sdk.ComposeSupergraph(input)
+
+	result := CompositionResultModel{
+		Supergraph: types.StringNull(),
+		Errors:     make([]CompositionErrorModel, 0, len(errs)),
+	}
+	if len(errs) == 0 {
+		result.Supergraph = types.StringValue(supergraph)
+	}
+	for _, e := range errs {
+		result.Errors = append(result.Errors, CompositionErrorModel{
+			Code:    types.StringValue(e.Code),
+			Message: types.StringValue(e.Message),
+		})
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
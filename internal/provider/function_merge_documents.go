@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &MergeDocumentsFunction{}
+
+// NewMergeDocumentsFunction is a helper function to simplify the provider implementation.
+func NewMergeDocumentsFunction() function.Function {
+	return &MergeDocumentsFunction{}
+}
+
+// MergeDocumentsFunction defines the function implementation.
+type MergeDocumentsFunction struct{}
+
+// Metadata returns the function name.
+func (f *MergeDocumentsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "merge_documents"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *MergeDocumentsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Merge persisted document manifests",
+		MarkdownDescription: "Merges persisted document manifests into a single JSON map of hash to document body, " +
+			"for the `documents` of `hive_app_create`. Each manifest is either such a map, " +
+			"or an Apollo persisted query manifest. A plain list of document bodies isn't supported, as it has no hashes; " +
+			"pass it to `hive_app_create` with `hash_mode = \"compute\"` instead. " +
+			"Documents that are in several manifests are only included once, " +
+			"and the function fails when the same hash is used for different documents, within a manifest or across manifests.",
+
+		VariadicParameter: function.StringParameter{
+			Name:                "manifests",
+			MarkdownDescription: "The JSON encoded manifests",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run merges the manifests.
+func (f *MergeDocumentsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var manifests []string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &manifests))
+	if resp.Error != nil {
+		return
+	}
+
+	merged, err := sdk.MergeDocuments(manifests)
+	if err != nil {
+		var manifestErr sdk.ManifestError
+		if errors.As(err, &manifestErr) {
+			resp.Error = function.NewArgumentFuncError(int64(manifestErr.Index), "Invalid manifest: "+manifestErr.Err.Error())
+			return
+		}
+		resp.Error = function.NewFuncError("Failed to merge manifests: " + err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, merged))
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NormalizeSchemaFunction{}
+
+// NewNormalizeSchemaFunction is a helper function to simplify the provider implementation.
+func NewNormalizeSchemaFunction() function.Function {
+	return &NormalizeSchemaFunction{}
+}
+
+// NormalizeSchemaFunction defines the function implementation.
+type NormalizeSchemaFunction struct{}
+
+// Metadata returns the function name.
+func (f *NormalizeSchemaFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_schema"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *NormalizeSchemaFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a GraphQL schema",
+		MarkdownDescription: "Returns the GraphQL schema in a canonical printed form, without comments. " +
+			"This is the form in which the provider publishes and checks schemas. " +
+			"Use it to compare or hash schemas regardless of their formatting, for example as a `triggers_replace` key.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "sdl",
+				MarkdownDescription: "The GraphQL schema",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run parses and normalizes the schema.
+func (f *NormalizeSchemaFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var sdl string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &sdl))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := sdk.NormalizeSchema(sdl)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid schema: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
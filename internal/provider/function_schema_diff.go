@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SchemaDiffFunction{}
+
+// NewSchemaDiffFunction is a helper function to simplify the provider implementation.
+func NewSchemaDiffFunction() function.Function {
+	return &SchemaDiffFunction{}
+}
+
+// SchemaDiffFunction defines the function implementation.
+type SchemaDiffFunction struct{}
+
+// SchemaChangeModel describes a single change returned by the function.
+type SchemaChangeModel struct {
+	Type        types.String `tfsdk:"type"`
+	Criticality types.String `tfsdk:"criticality"`
+	Path        []string     `tfsdk:"path"`
+	Message     types.String `tfsdk:"message"`
+}
+
+var schemaChangeAttrTypes = map[string]attr.Type{
+	"type":        types.StringType,
+	"criticality": types.StringType,
+	"path":        types.ListType{ElemType: types.StringType},
+	"message":     types.StringType,
+}
+
+// Metadata returns the function name.
+func (f *SchemaDiffFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schema_diff"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *SchemaDiffFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compare two GraphQL schemas",
+		MarkdownDescription: "Returns the changes between two GraphQL schemas, without contacting Hive. " +
+			"Each change has a `type` such as `FIELD_REMOVED`, a `criticality` of `Breaking`, `Dangerous` or `Safe`, " +
+			"the `path` of the changed schema coordinate and a human readable `message`, " +
+			"using the same vocabulary as the schema checks of Hive. " +
+			"Use it to review or gate schema changes in the plan, for example from a `check` block.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "old_sdl",
+				MarkdownDescription: "The current GraphQL schema",
+			},
+			function.StringParameter{
+				Name:                "new_sdl",
+				MarkdownDescription: "The new GraphQL schema",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: schemaChangeAttrTypes},
+		},
+	}
+}
+
+// Run compares the schemas.
+func (f *SchemaDiffFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var oldSDL, newSDL string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &oldSDL, &newSDL))
+	if resp.Error != nil {
+		return
+	}
+
+	if _, err := sdk.NormalizeSchema(oldSDL); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid schema: "+err.Error())
+		return
+	}
+	if _, err := sdk.NormalizeSchema(newSDL); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid schema: "+err.Error())
+		return
+	}
+
+	changes, err := sdk.DiffSchemas(oldSDL, newSDL)
+	if err != nil {
+		resp.Error = function.NewFuncError("Failed to compare schemas: " + err.Error())
+		return
+	}
+
+	models := make([]SchemaChangeModel, 0, len(changes))
+	for _, change := range changes {
+		path := change.Path
+		if path == nil {
+			path = []string{}
+		}
+		models = append(models, SchemaChangeModel{
+			Type:        types.StringValue(change.Type),
+			Criticality: types.StringValue(change.Criticality),
+			Path:        path,
+			Message:     types.StringValue(change.Message),
+		})
+	}
+
+	result, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: schemaChangeAttrTypes}, models)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -2,16 +2,25 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"net/http"
 	"os"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -33,9 +42,27 @@ type HiveProvider struct {
 
 // HiveProviderModel describes the provider data model.
 type HiveProviderModel struct {
-	Endpoint     types.String `tfsdk:"endpoint"`
-	Token        types.String `tfsdk:"token"`
-	Organization types.String `tfsdk:"organization"`
+	Endpoint        types.String `tfsdk:"endpoint"`
+	Token           types.String `tfsdk:"token"`
+	TokenFile       types.String `tfsdk:"token_file"`
+	ConfigFile      types.String `tfsdk:"config_file"`
+	Organization    types.String `tfsdk:"organization"`
+	Project         types.String `tfsdk:"project"`
+	Target          types.String `tfsdk:"target"`
+	MaxRetries      types.Int64  `tfsdk:"max_retries"`
+	RequestTimeout  types.String `tfsdk:"request_timeout"`
+	TotalTimeout    types.String `tfsdk:"total_timeout"`
+	DebugLogMode    types.String `tfsdk:"debug_log_mode"`
+	UserAgentSuffix types.String `tfsdk:"user_agent_suffix"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientCertFile     types.String `tfsdk:"client_cert_file"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	ClientKeyFile      types.String `tfsdk:"client_key_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
 }
 
 func (p *HiveProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -45,20 +72,113 @@ func (p *HiveProvider) Metadata(ctx context.Context, req provider.MetadataReques
 
 func (p *HiveProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		MarkdownDescription: "## Upgrading\n\n" +
+			"Earlier versions used the target the token belongs to when only `organization` was set. " +
+			"Such a partial target selector is now rejected at plan time. Set `project` and `target` as well, " +
+			"on the provider or on every resource and data source, or remove `organization` to keep using " +
+			"the target the token belongs to.",
+
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "The endpoint of the Hive API",
-				Optional:            true,
+				MarkdownDescription: "The endpoint of the Hive API. Defaults to the `HIVE_ENDPOINT` environment variable, " +
+					"the registry endpoint of the Hive CLI configuration file or `https://app.graphql-hive.com/graphql`.",
+				Optional: true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "The token to authenticate with the registry",
-				Required:            true,
-				Sensitive:           true,
+				MarkdownDescription: "The token to authenticate with the registry. Defaults to the contents of `token_file`, " +
+					"the `HIVE_TOKEN` environment variable or the registry access token of the Hive CLI configuration file.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "A file containing the token to authenticate with the registry. Conflicts with `token`.",
+				Optional:            true,
+			},
+			"config_file": schema.StringAttribute{
+				MarkdownDescription: "The Hive CLI configuration file to read the registry endpoint and access token from. " +
+					"Defaults to `hive.json` in the working directory, which is ignored when it doesn't exist.",
+				Optional: true,
 			},
 			"organization": schema.StringAttribute{
-				MarkdownDescription: "The organization within the registry",
+				MarkdownDescription: "The organization within the registry. Defaults to the `HIVE_ORGANIZATION` environment variable. " +
+					"When set, `project` and `target` have to be set as well, on the provider or on the resources and data sources.",
+				Optional: true,
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The default project for resources and data sources that don't set a project. " +
+					"Defaults to the `HIVE_PROJECT` environment variable.",
+				Optional: true,
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The default target for resources and data sources that don't set a target. " +
+					"Defaults to the `HIVE_TARGET` environment variable. When none of `organization`, `project` and " +
+					"`target` are set, the target the token belongs to is used.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "The number of times a request is retried after a transient error, defaults to 3. " +
+					"Mutations are only retried when Hive didn't process them.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "The timeout of a single request attempt, for example `30s`. Defaults to `60s`.",
+				Optional:            true,
+			},
+			"total_timeout": schema.StringAttribute{
+				MarkdownDescription: "The timeout of a request including all retries, for example `5m`. Defaults to no timeout.",
+				Optional:            true,
+			},
+			"debug_log_mode": schema.StringAttribute{
+				MarkdownDescription: "What to log for every request when running with `TF_LOG=DEBUG`. Either `full` to log the " +
+					"requests and responses with their secrets redacted, or `operations` to only log the GraphQL operation " +
+					"names and timings. Defaults to `full`.",
+				Optional: true,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "A suffix for the User-Agent of every request, for example to identify the pipeline. " +
+					"Defaults to the `TF_APPEND_USER_AGENT` environment variable.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust next to the system certificates, for example for a " +
+					"self-hosted Hive behind a private PKI. Conflicts with `ca_cert_file`.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "A file with PEM encoded CA certificates to trust next to the system certificates. " +
+					"Conflicts with `ca_cert_pem`.",
+				Optional: true,
+			},
+			"client_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded client certificate for mutual TLS. Conflicts with `client_cert_file`.",
+				Optional:            true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "A file with the PEM encoded client certificate for mutual TLS. Conflicts with `client_cert_pem`.",
 				Optional:            true,
 			},
+			"client_key_pem": schema.StringAttribute{
+				MarkdownDescription: "The PEM encoded private key of the client certificate. Conflicts with `client_key_file`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "A file with the PEM encoded private key of the client certificate. Conflicts with `client_key_pem`.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the server certificate. Only use this for local test instances. " +
+					"Defaults to `false`.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy to reach Hive through, for example `http://proxy:3128`. Defaults to the " +
+					"`HTTPS_PROXY` and `HTTP_PROXY` environment variables.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -71,33 +191,237 @@ func (p *HiveProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	endpoint := "https://app.graphql-hive.com/graphql"
-	if !data.Endpoint.IsNull() {
-		endpoint = data.Endpoint.ValueString()
+	cliConfig, err := readCLIConfig(data.ConfigFile)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config_file"), "Unable to read Hive CLI configuration", err.Error())
+		return
+	}
+
+	endpoint := firstNonEmpty(
+		data.Endpoint.ValueString(),
+		os.Getenv("HIVE_ENDPOINT"),
+		cliConfig.Endpoint,
+		"https://app.graphql-hive.com/graphql",
+	)
+
+	organization := firstNonEmpty(
+		data.Organization.ValueString(),
+		os.Getenv("HIVE_ORGANIZATION"),
+	)
+
+	project := firstNonEmpty(
+		data.Project.ValueString(),
+		os.Getenv("HIVE_PROJECT"),
+	)
+
+	target := firstNonEmpty(
+		data.Target.ValueString(),
+		os.Getenv("HIVE_TARGET"),
+	)
+
+	if !data.Token.IsNull() && !data.TokenFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_file"),
+			"Conflicting token configuration",
+			"Only one of token and token_file can be set.",
+		)
+		return
+	}
+
+	var tokenFromFile string
+	if !data.TokenFile.IsNull() {
+		content, err := os.ReadFile(data.TokenFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("token_file"), "Unable to read token file", err.Error())
+			return
+		}
+		tokenFromFile = strings.TrimSpace(string(content))
+	}
+
+	token := firstNonEmpty(
+		data.Token.ValueString(),
+		tokenFromFile,
+		os.Getenv("HIVE_TOKEN"),
+		cliConfig.AccessToken,
+	)
+	if token == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Missing token",
+			"The provider requires a registry token. Set token or token_file, the HIVE_TOKEN environment variable, "+
+				"or the registry access token in the Hive CLI configuration file.",
+		)
+		return
+	}
+
+	retryOptions := sdk.RetryOptions{
+		MaxRetries:     sdk.DefaultMaxRetries,
+		RequestTimeout: sdk.DefaultRequestTimeout,
+	}
+	if !data.MaxRetries.IsNull() {
+		retryOptions.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.RequestTimeout.IsNull() {
+		timeout, err := parseDuration(data.RequestTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid duration", err.Error())
+			return
+		}
+		retryOptions.RequestTimeout = timeout
+	}
+
+	var totalTimeout time.Duration
+	if !data.TotalTimeout.IsNull() {
+		timeout, err := parseDuration(data.TotalTimeout.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("total_timeout"), "Invalid duration", err.Error())
+			return
+		}
+		totalTimeout = timeout
+	}
+
+	debugOptions := sdk.DebugOptions{
+		Mode:        sdk.DebugLogModeFull,
+		MaxBodySize: sdk.DefaultMaxLogBodySize,
+	}
+	if !data.DebugLogMode.IsNull() {
+		if !slices.Contains(sdk.DebugLogModes, data.DebugLogMode.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("debug_log_mode"),
+				"Invalid debug log mode",
+				fmt.Sprintf("Expected one of %s, got: %q", strings.Join(sdk.DebugLogModes, ", "), data.DebugLogMode.ValueString()),
+			)
+			return
+		}
+		debugOptions.Mode = data.DebugLogMode.ValueString()
+	}
+
+	transportOptions := sdk.TransportOptions{
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           data.ProxyURL.ValueString(),
+	}
+	for _, pem := range []struct {
+		value             *string
+		pem, file         types.String
+		pemName, fileName string
+	}{
+		{&transportOptions.CACertPEM, data.CACertPEM, data.CACertFile, "ca_cert_pem", "ca_cert_file"},
+		{&transportOptions.ClientCertPEM, data.ClientCertPEM, data.ClientCertFile, "client_cert_pem", "client_cert_file"},
+		{&transportOptions.ClientKeyPEM, data.ClientKeyPEM, data.ClientKeyFile, "client_key_pem", "client_key_file"},
+	} {
+		value, diags := readPEM(pem.pem, pem.file, pem.pemName, pem.fileName)
+		resp.Diagnostics.Append(diags...)
+		*pem.value = value
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if transportOptions.InsecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"Server certificate verification is disabled",
+			"The provider doesn't verify the certificate of the Hive endpoint, so the token may be sent to an impostor.",
+		)
 	}
 
-	token := os.Getenv("HIVE_TOKEN")
-	if !data.Token.IsNull() {
-		token = data.Token.ValueString()
+	transport, err := sdk.NewTransport(transportOptions)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid transport configuration", err.Error())
+		return
 	}
 
 	tflog.Info(ctx, fmt.Sprintf("Configuring Hive provider with endpoint: %s", endpoint))
 
 	httpClient := &http.Client{
-		Transport: sdk.NewDebugTransport(http.DefaultTransport),
+		Transport: sdk.NewRetryTransport(sdk.NewDebugTransport(transport, debugOptions), retryOptions),
+		Timeout:   totalTimeout,
+	}
+	userAgent := sdk.UserAgent(p.version, req.TerraformVersion, firstNonEmpty(
+		data.UserAgentSuffix.ValueString(),
+		os.Getenv("TF_APPEND_USER_AGENT"),
+	))
+
+	client := sdk.NewHiveClient(httpClient, endpoint, organization, project, target, token, userAgent)
+
+	// Verify the token once, so a misconfigured token fails before any
+	// resource is planned.
+	info, err := client.LoadTokenInfo(ctx)
+	if errors.Is(err, sdk.ErrTokenNotFound) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Invalid token",
+			fmt.Sprintf("Hive doesn't recognize the configured token, check that it exists and hasn't been deleted: %s", err),
+		)
+		return
+	}
+	if err != nil {
+		// The token info is fetched again when it's needed, so only a token
+		// that Hive doesn't recognize stops the provider.
+		resp.Diagnostics.AddWarning(
+			"Unable to verify token",
+			fmt.Sprintf("Fetching the token info from %s failed, the token and its scopes aren't verified: %s", endpoint, err),
+		)
+	} else {
+		tflog.Info(ctx, fmt.Sprintf(
+			"Authenticated with token %q for target %s/%s/%s",
+			info.Name, info.OrganizationSlug, info.ProjectSlug, info.TargetSlug,
+		))
 	}
-	client := sdk.NewHiveClient(httpClient, endpoint, data.Organization.ValueString(), token)
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// readCLIConfig reads the Hive CLI configuration file. The default file is
+// optional, so an empty configuration is returned when it doesn't exist.
+func readCLIConfig(configFile types.String) (*sdk.CLIConfig, error) {
+	if !configFile.IsNull() {
+		return sdk.ReadCLIConfig(configFile.ValueString())
+	}
+
+	config, err := sdk.ReadCLIConfig(sdk.DefaultCLIConfigFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return &sdk.CLIConfig{}, nil
+	}
+	return config, err
+}
+
+// readPEM returns the PEM value of the attribute, or the contents of the file
+// attribute.
+func readPEM(value types.String, file types.String, valueName string, fileName string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !value.IsNull() && !file.IsNull() {
+		diags.AddAttributeError(
+			path.Root(fileName),
+			"Conflicting certificate configuration",
+			fmt.Sprintf("Only one of %s and %s can be set.", valueName, fileName),
+		)
+		return "", diags
+	}
+
+	if file.IsNull() {
+		return value.ValueString(), diags
+	}
+
+	content, err := os.ReadFile(file.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root(fileName), "Unable to read file", err.Error())
+		return "", diags
+	}
+	return string(content), diags
+}
+
 func (p *HiveProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewHiveSchemaCheckResource,
 		NewHiveSchemaPublishResource,
 		NewHiveAppCreateResource,
 		NewHiveAppPublishResource,
+		NewHiveAppDeploymentResource,
+		NewHiveAppDeploymentRetentionResource,
+		NewHiveOrganizationSettingsResource,
 	}
 }
 
@@ -108,11 +432,29 @@ func (p *HiveProvider) EphemeralResources(ctx context.Context) []func() ephemera
 func (p *HiveProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewHiveSchemaCheckDataSource,
+		NewHiveAppDeploymentsDataSource,
+		NewHiveSchemaVersionDataSource,
+		NewHiveTargetDataSource,
+		NewHiveTargetsDataSource,
+		NewHiveProjectDataSource,
+		NewHiveProjectsDataSource,
+		NewHiveSchemaChecksDataSource,
+		NewHiveSchemaCoordinateUsageDataSource,
+		NewHiveUnusedSchemaDataSource,
+		NewHiveOperationsStatsDataSource,
+		NewHiveClientStatsDataSource,
+		NewHiveSchemaPolicyRulesDataSource,
+		NewHiveOrganizationDataSource,
 	}
 }
 
 func (p *HiveProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewNormalizeSchemaFunction,
+		NewSchemaDiffFunction,
+		NewComposeSupergraphFunction,
+		NewMergeDocumentsFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -3,6 +3,9 @@ package provider
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,6 +21,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &HiveAppCreateResource{}
 var _ resource.ResourceWithImportState = &HiveAppCreateResource{}
+var _ resource.ResourceWithValidateConfig = &HiveAppCreateResource{}
+var _ resource.ResourceWithModifyPlan = &HiveAppCreateResource{}
 
 // NewHiveAppCreateResource is a helper function to simplify the provider implementation.
 func NewHiveAppCreateResource() resource.Resource {
@@ -31,10 +36,13 @@ type HiveAppCreateResource struct {
 
 // HiveAppCreateResourceModel describes the resource data model.
 type HiveAppCreateResourceModel struct {
-	Id        types.String `tfsdk:"id"`
-	Name      types.String `tfsdk:"name"`
-	Version   types.String `tfsdk:"version"`
-	Documents types.String `tfsdk:"documents"`
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Version           types.String `tfsdk:"version"`
+	Documents         types.String `tfsdk:"documents"`
+	HashMode          types.String `tfsdk:"hash_mode"`
+	HashAlgorithm     types.String `tfsdk:"hash_algorithm"`
+	ValidateDocuments types.Bool   `tfsdk:"validate_documents"`
 }
 
 // Metadata returns the resource type name.
@@ -63,11 +71,27 @@ func (r *HiveAppCreateResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"documents": schema.StringAttribute{
-				MarkdownDescription: "A file with a list of generated document hashes",
-				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				MarkdownDescription: "A file with a list of generated document hashes. " +
+					"The app is only created again when the hashes or the document bodies change.",
+				Required: true,
+			},
+			"hash_mode": schema.StringAttribute{
+				MarkdownDescription: "How to handle the hashes in `documents`. Either `trust` to use the keys as-is, " +
+					"`verify` to fail when a key doesn't match the hash of its document or `compute` to ignore the keys " +
+					"and compute the hashes. With `compute` the documents may also be a list of document bodies. " +
+					"In every mode a document body that is registered under several hashes is rejected. " +
+					"Defaults to `trust`.",
+				Optional: true,
+			},
+			"hash_algorithm": schema.StringAttribute{
+				MarkdownDescription: "The algorithm used to verify or compute the document hashes, either `sha256` or `md5`. " +
+					"Defaults to `sha256`.",
+				Optional: true,
+			},
+			"validate_documents": schema.BoolAttribute{
+				MarkdownDescription: "Validate all documents against the latest valid schema of the target at plan time. " +
+					"Defaults to `false`.",
+				Optional: true,
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -98,6 +122,133 @@ func (r *HiveAppCreateResource) Configure(ctx context.Context, req resource.Conf
 	r.client = client
 }
 
+// ValidateConfig verifies the document hashes at plan time.
+func (r *HiveAppCreateResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HiveAppCreateResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.HashMode.IsNull() && !data.HashMode.IsUnknown() && !slices.Contains(sdk.HashModes, data.HashMode.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hash_mode"),
+			"Invalid hash mode",
+			fmt.Sprintf("Expected one of %s, got: %q", strings.Join(sdk.HashModes, ", "), data.HashMode.ValueString()),
+		)
+	}
+
+	if !data.HashAlgorithm.IsNull() && !data.HashAlgorithm.IsUnknown() && !slices.Contains(sdk.HashAlgorithms, data.HashAlgorithm.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("hash_algorithm"),
+			"Invalid hash algorithm",
+			fmt.Sprintf("Expected one of %s, got: %q", strings.Join(sdk.HashAlgorithms, ", "), data.HashAlgorithm.ValueString()),
+		)
+	}
+
+	if resp.Diagnostics.HasError() || data.Documents.IsUnknown() || data.HashMode.IsUnknown() || data.HashAlgorithm.IsUnknown() {
+		return
+	}
+
+	errs, err := sdk.ValidateDocuments(data.Documents.ValueString(), data.HashMode.ValueString(), data.HashAlgorithm.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("documents"), "Invalid documents", err.Error())
+		return
+	}
+
+	for _, e := range errs {
+		resp.Diagnostics.AddAttributeError(path.Root("documents"), "Invalid document hash", e.Error())
+	}
+}
+
+// ModifyPlan replaces the app when the uploaded documents change, and
+// validates the target and the documents against the latest valid schema of
+// the target when a new app version is planned.
+func (r *HiveAppCreateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan HiveAppCreateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The documents are only uploaded again when the uploaded hashes or
+	// bodies change, as hash_mode and hash_algorithm only change how the
+	// hashes are checked.
+	upload := true
+	if !req.State.Raw.IsNull() {
+		var state HiveAppCreateResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if documentsChanged(state, plan) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("documents"))
+		} else {
+			upload = !plan.Name.Equal(state.Name) || !plan.Version.Equal(state.Version)
+		}
+	}
+
+	// Nothing to validate when the provider isn't configured yet.
+	if r.client == nil {
+		return
+	}
+
+	// The app is always created on the default target of the provider.
+	resp.Diagnostics.Append(validateTarget(r.client, types.StringNull(), types.StringNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if upload {
+		resp.Diagnostics.Append(checkScope(r.client, types.StringNull(), types.StringNull(), sdk.ScopeRegistryWrite, "create app deployments")...)
+	}
+
+	if !upload || !plan.ValidateDocuments.ValueBool() || plan.Documents.IsUnknown() {
+		return
+	}
+
+	sdl, err := r.client.GetLatestValidSchema(ctx, "", "")
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Fetching schema failed", err))
+		return
+	}
+
+	errs, err := sdk.ValidateDocumentsAgainstSchema(sdl, plan.Documents.ValueString(), plan.HashMode.ValueString(), plan.HashAlgorithm.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("documents"), "Invalid documents", err.Error())
+		return
+	}
+
+	for _, e := range errs {
+		resp.Diagnostics.AddAttributeError(path.Root("documents"), "Invalid operation", e.Error())
+	}
+}
+
+// documentsChanged reports whether the documents uploaded for the plan differ
+// from the ones uploaded for the state.
+func documentsChanged(state HiveAppCreateResourceModel, plan HiveAppCreateResourceModel) bool {
+	if plan.Documents.IsUnknown() || plan.HashMode.IsUnknown() || plan.HashAlgorithm.IsUnknown() {
+		return true
+	}
+
+	before, err := sdk.DocumentHashes(state.Documents.ValueString(), state.HashMode.ValueString(), state.HashAlgorithm.ValueString())
+	if err != nil {
+		return !plan.Documents.Equal(state.Documents)
+	}
+	after, err := sdk.DocumentHashes(plan.Documents.ValueString(), plan.HashMode.ValueString(), plan.HashAlgorithm.ValueString())
+	if err != nil {
+		return true
+	}
+	return !maps.Equal(before, after)
+}
+
 // Create handles the creation of the resource.
 func (r *HiveAppCreateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data HiveAppCreateResourceModel
@@ -134,19 +285,20 @@ func (r *HiveAppCreateResource) Read(ctx context.Context, req resource.ReadReque
 
 // Update handles updates to the resource.
 func (r *HiveAppCreateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data HiveAppCreateResourceModel
+	var data, state HiveAppCreateResourceModel
 
 	// Read Terraform plan data into the model.
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	diag := r.ExecuteRequest(ctx, &data)
-	if diag != nil {
-		resp.Diagnostics.Append(*diag)
-		return
-	}
+	// All changes to what is sent to Hive require a replacement, so only
+	// settings like validate_documents, or changes to the documents that
+	// don't change the uploaded hashes and bodies, end up here and the app
+	// version is kept.
+	data.Id = state.Id
 
 	// Save updated data into Terraform state.
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -163,13 +315,15 @@ func (r *HiveAppCreateResource) ImportState(ctx context.Context, req resource.Im
 
 func (r *HiveAppCreateResource) ExecuteRequest(ctx context.Context, data *HiveAppCreateResourceModel) *diag.ErrorDiagnostic {
 	result, err := r.client.CreateApp(ctx, &sdk.CreateAppInput{
-		Name:      data.Name.ValueString(),
-		Version:   data.Version.ValueString(),
-		Documents: data.Documents.ValueString(),
+		Name:          data.Name.ValueString(),
+		Version:       data.Version.ValueString(),
+		Documents:     data.Documents.ValueString(),
+		HashMode:      data.HashMode.ValueString(),
+		HashAlgorithm: data.HashAlgorithm.ValueString(),
 	})
 
 	if err != nil {
-		d := diag.NewErrorDiagnostic("App creation failed", err.Error())
+		d := newErrorDiagnostic("App creation failed", err)
 		return &d
 	}
 
@@ -0,0 +1,390 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HiveAppDeploymentResource{}
+var _ resource.ResourceWithImportState = &HiveAppDeploymentResource{}
+var _ resource.ResourceWithModifyPlan = &HiveAppDeploymentResource{}
+
+// NewHiveAppDeploymentResource is a helper function to simplify the provider implementation.
+func NewHiveAppDeploymentResource() resource.Resource {
+	return &HiveAppDeploymentResource{}
+}
+
+// HiveAppDeploymentResource defines the resource implementation.
+type HiveAppDeploymentResource struct {
+	client *sdk.HiveClient
+}
+
+// HiveAppDeploymentResourceModel describes the resource data model.
+type HiveAppDeploymentResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Version   types.String `tfsdk:"version"`
+	Documents types.String `tfsdk:"documents"`
+	Activate  types.Bool   `tfsdk:"activate"`
+	Status    types.String `tfsdk:"status"`
+	Project   types.String `tfsdk:"project"`
+	Target    types.String `tfsdk:"target"`
+}
+
+// Metadata returns the resource type name.
+func (r *HiveAppDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_deployment"
+}
+
+// Schema defines the schema for the hive_app_deployment resource.
+func (r *HiveAppDeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to create, upload and activate an app deployment within Hive. " +
+			"The app deployment is retired when the resource is destroyed.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The app name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The commit or version identifier",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"documents": schema.StringAttribute{
+				MarkdownDescription: "A file with a list of generated document hashes. " +
+					"The documents of an activated app deployment can't be changed, bump the `version` instead.",
+				Required: true,
+			},
+			"activate": schema.BoolAttribute{
+				MarkdownDescription: "Whether to activate the app deployment after uploading the documents. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The status of the app deployment, one of `pending`, `active` or `retired`",
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure saves the provider configured HTTP client on the resource.
+func (r *HiveAppDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan rejects changes that can't be applied to an activated app
+// deployment, since Hive locks those for modifications.
+func (r *HiveAppDeploymentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan HiveAppDeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, plan.Project, plan.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.Plan.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.Append(checkScope(r.client, plan.Project, plan.Target, sdk.ScopeRegistryWrite, "create and activate app deployments")...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state HiveAppDeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new version replaces the app deployment, so everything may change.
+	if !plan.Version.Equal(state.Version) || !plan.Name.Equal(state.Name) {
+		return
+	}
+
+	if state.Status.ValueString() != "active" {
+		return
+	}
+
+	// Imported app deployments don't know their documents yet.
+	if !state.Documents.IsNull() && !plan.Documents.IsUnknown() && !plan.Documents.Equal(state.Documents) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("documents"),
+			"App deployment is locked",
+			fmt.Sprintf(
+				"App deployment %s@%s has already been activated and its documents can't be changed. "+
+					"Bump the version to publish the new documents.",
+				state.Name.ValueString(), state.Version.ValueString(),
+			),
+		)
+	}
+
+	if !plan.Activate.IsUnknown() && !plan.Activate.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("activate"),
+			"App deployment is locked",
+			fmt.Sprintf(
+				"App deployment %s@%s has already been activated and can't be deactivated. "+
+					"Remove the resource to retire the app deployment.",
+				state.Name.ValueString(), state.Version.ValueString(),
+			),
+		)
+	}
+}
+
+// Create handles the creation of the resource.
+func (r *HiveAppDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HiveAppDeploymentResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.CreateApp(ctx, &sdk.CreateAppInput{
+		Name:      data.Name.ValueString(),
+		Version:   data.Version.ValueString(),
+		Documents: data.Documents.ValueString(),
+		Project:   data.Project.ValueString(),
+		Target:    data.Target.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("App creation failed", err))
+	}
+	if result == nil {
+		return
+	}
+
+	data.Id = types.StringValue(result.Id)
+	data.Status = types.StringValue(result.Status)
+
+	// Save the created app deployment first, so it isn't left untracked when
+	// adding the documents failed or activating it fails.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Activate.ValueBool() {
+		diag := r.activate(ctx, &data)
+		if diag != nil {
+			resp.Diagnostics.Append(*diag)
+			return
+		}
+	}
+
+	// Save the data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *HiveAppDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HiveAppDeploymentResourceModel
+
+	// Retrieve state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.GetApp(ctx, &sdk.GetAppInput{
+		Name:    data.Name.ValueString(),
+		Version: data.Version.ValueString(),
+		Project: data.Project.ValueString(),
+		Target:  data.Target.ValueString(),
+	})
+	// A missing target is an error, as the token may not be able to access
+	// it, and removing the app deployment from the state would recreate it.
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading app deployment failed", err))
+		return
+	}
+
+	// The app deployment was retired or removed outside of Terraform.
+	if result == nil || result.Status == "retired" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Id = types.StringValue(result.Id)
+	data.Status = types.StringValue(result.Status)
+	if data.Activate.IsNull() {
+		data.Activate = types.BoolValue(result.Status == "active")
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update handles updates to the resource.
+func (r *HiveAppDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state HiveAppDeploymentResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = state.Id
+	data.Status = state.Status
+
+	// Documents can still be added as long as the app deployment is pending.
+	if !data.Documents.Equal(state.Documents) {
+		err := r.client.AddAppDocuments(ctx, &sdk.CreateAppInput{
+			Name:      data.Name.ValueString(),
+			Version:   data.Version.ValueString(),
+			Documents: data.Documents.ValueString(),
+			Project:   data.Project.ValueString(),
+			Target:    data.Target.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.Append(newErrorDiagnostic("App update failed", err))
+			return
+		}
+	}
+
+	if data.Activate.ValueBool() && state.Status.ValueString() != "active" {
+		diag := r.activate(ctx, &data)
+		if diag != nil {
+			resp.Diagnostics.Append(*diag)
+			return
+		}
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete retires the app deployment.
+func (r *HiveAppDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data HiveAppDeploymentResourceModel
+
+	// Retrieve state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.RetireApp(ctx, &sdk.RetireAppInput{
+		Name:    data.Name.ValueString(),
+		Version: data.Version.ValueString(),
+		Project: data.Project.ValueString(),
+		Target:  data.Target.ValueString(),
+	})
+	// The app deployment is already gone, so there is nothing to retire.
+	if errors.Is(err, sdk.ErrAppNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("App retirement failed", err))
+		return
+	}
+}
+
+// ImportState allows the resource to be imported into Terraform using the
+// `<name>/<version>` format.
+func (r *HiveAppDeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	name, version, ok := strings.Cut(req.ID, "/")
+	if !ok || name == "" || version == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <name>/<version>. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("version"), version)...)
+}
+
+func (r *HiveAppDeploymentResource) activate(ctx context.Context, data *HiveAppDeploymentResourceModel) *diag.ErrorDiagnostic {
+	result, err := r.client.PublishApp(ctx, &sdk.PublishAppInput{
+		Name:    data.Name.ValueString(),
+		Version: data.Version.ValueString(),
+		Project: data.Project.ValueString(),
+		Target:  data.Target.ValueString(),
+	})
+
+	if err != nil {
+		d := newErrorDiagnostic("App activation failed", err)
+		return &d
+	}
+
+	if result.IsSkipped {
+		tflog.Info(ctx, fmt.Sprintf("App deployment %s@%s was already activated", result.AppName, result.AppVersion))
+	}
+
+	data.Id = types.StringValue(result.Id)
+	data.Status = types.StringValue(result.Status)
+
+	return nil
+}
@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HiveAppDeploymentRetentionResource{}
+var _ resource.ResourceWithModifyPlan = &HiveAppDeploymentRetentionResource{}
+var _ resource.ResourceWithValidateConfig = &HiveAppDeploymentRetentionResource{}
+
+// NewHiveAppDeploymentRetentionResource is a helper function to simplify the provider implementation.
+func NewHiveAppDeploymentRetentionResource() resource.Resource {
+	return &HiveAppDeploymentRetentionResource{}
+}
+
+// HiveAppDeploymentRetentionResource defines the resource implementation.
+type HiveAppDeploymentRetentionResource struct {
+	client *sdk.HiveClient
+}
+
+// HiveAppDeploymentRetentionResourceModel describes the resource data model.
+type HiveAppDeploymentRetentionResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Project         types.String `tfsdk:"project"`
+	Target          types.String `tfsdk:"target"`
+	KeepLatest      types.Int64  `tfsdk:"keep_latest"`
+	KeepUsedWithin  types.String `tfsdk:"keep_used_within"`
+	Versions        types.List   `tfsdk:"versions"`
+	RetiredVersions types.List   `tfsdk:"retired_versions"`
+}
+
+// Metadata returns the resource type name.
+func (r *HiveAppDeploymentRetentionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_deployment_retention"
+}
+
+// Schema defines the schema for the hive_app_deployment_retention resource.
+func (r *HiveAppDeploymentRetentionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to retire old app deployment versions on every apply. " +
+			"The most recent active versions, and optionally the versions that were recently used, are kept. " +
+			"The versions that would be retired are shown in the plan.\n\n" +
+			"Hive doesn't return the app deployments in a guaranteed order, so the most recent versions are determined " +
+			"by sorting the versions as semantic versions, or by their position in `versions` when set. " +
+			"Nothing is retired when the order of the active versions can't be determined.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The app name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project": schema.StringAttribute{
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keep_latest": schema.Int64Attribute{
+				MarkdownDescription: "The number of most recent active versions to keep, at least 1",
+				Required:            true,
+			},
+			"keep_used_within": schema.StringAttribute{
+				MarkdownDescription: "Also keep the active versions that were used within this duration, for example `720h` or `30d`",
+				Optional:            true,
+			},
+			"versions": schema.ListAttribute{
+				MarkdownDescription: "The versions of the app ordered from oldest to newest, to determine the most recent versions " +
+					"when the versions aren't semantic versions. All active versions must be included.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"retired_versions": schema.ListAttribute{
+				MarkdownDescription: "The versions retired by the last apply",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The resource ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure saves the provider configured HTTP client on the resource.
+func (r *HiveAppDeploymentRetentionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the retention settings.
+func (r *HiveAppDeploymentRetentionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HiveAppDeploymentRetentionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.KeepLatest.IsUnknown() && !data.KeepLatest.IsNull() && data.KeepLatest.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("keep_latest"),
+			"Invalid retention",
+			"At least the latest active version has to be kept, set keep_latest to 1 or more.",
+		)
+	}
+
+	if !data.KeepUsedWithin.IsUnknown() && !data.KeepUsedWithin.IsNull() {
+		if _, err := parseDuration(data.KeepUsedWithin.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("keep_used_within"), "Invalid duration", err.Error())
+		}
+	}
+}
+
+// ModifyPlan previews the versions that fall outside of the retention. When
+// there are any, the plan contains an update that retires them.
+func (r *HiveAppDeploymentRetentionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to preview on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan HiveAppDeploymentRetentionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = plan.Name
+
+	resp.Diagnostics.Append(validateTarget(r.client, plan.Project, plan.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.Plan.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.Append(checkScope(r.client, plan.Project, plan.Target, sdk.ScopeRegistryWrite, "retire app deployments")...)
+	}
+
+	// The provider isn't configured yet, or the input isn't known yet.
+	if r.client == nil || plan.Name.IsUnknown() || plan.KeepLatest.IsUnknown() || plan.KeepUsedWithin.IsUnknown() ||
+		plan.Versions.IsUnknown() || plan.Project.IsUnknown() || plan.Target.IsUnknown() {
+		plan.RetiredVersions = types.ListUnknown(types.StringType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	input, diags := r.retentionInput(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.AppRetention(ctx, input)
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Determining app retention failed", err))
+		return
+	}
+
+	versions := make([]string, 0, len(result.Retire))
+	for _, app := range result.Retire {
+		versions = append(versions, app.AppVersion)
+	}
+
+	// Keep the result of the previous apply when there is nothing to retire,
+	// so the resource doesn't show a change on every plan.
+	if len(versions) == 0 && !req.State.Raw.IsNull() {
+		var state HiveAppDeploymentRetentionResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.RetiredVersions = state.RetiredVersions
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	retired, diags := types.ListValueFrom(ctx, types.StringType, versions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.RetiredVersions = retired
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create handles the creation of the resource.
+func (r *HiveAppDeploymentRetentionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HiveAppDeploymentRetentionResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ExecuteRequest(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data. This is a no-op for this resource.
+func (r *HiveAppDeploymentRetentionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HiveAppDeploymentRetentionResourceModel
+
+	// Retrieve state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update handles updates to the resource.
+func (r *HiveAppDeploymentRetentionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data HiveAppDeploymentRetentionResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.ExecuteRequest(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete handles resource deletion. Retired versions stay retired, so this is
+// a no-op for this resource.
+func (r *HiveAppDeploymentRetentionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ExecuteRequest retires the versions that were previewed in the plan.
+func (r *HiveAppDeploymentRetentionResource) ExecuteRequest(ctx context.Context, data *HiveAppDeploymentRetentionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	input, d := r.retentionInput(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The versions are only unknown when the provider wasn't configured
+	// during the plan, determine them now instead.
+	if data.RetiredVersions.IsUnknown() {
+		result, err := r.client.AppRetention(ctx, input)
+		if err != nil {
+			diags.Append(newErrorDiagnostic("Determining app retention failed", err))
+			return diags
+		}
+
+		versions := make([]string, 0, len(result.Retire))
+		for _, app := range result.Retire {
+			versions = append(versions, app.AppVersion)
+		}
+
+		retired, d := types.ListValueFrom(ctx, types.StringType, versions)
+		diags.Append(d...)
+		data.RetiredVersions = retired
+	}
+
+	versions := []string{}
+	diags.Append(data.RetiredVersions.ElementsAs(ctx, &versions, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	err := r.client.RetireApps(ctx, input, versions)
+	if err != nil {
+		diags.Append(newErrorDiagnostic("App retirement failed", err))
+		return diags
+	}
+
+	data.Id = data.Name
+
+	return diags
+}
+
+func (r *HiveAppDeploymentRetentionResource) retentionInput(ctx context.Context, data *HiveAppDeploymentRetentionResourceModel) (*sdk.AppRetentionInput, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	versions := []string{}
+	if !data.Versions.IsNull() {
+		diags.Append(data.Versions.ElementsAs(ctx, &versions, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	var keepUsedWithin time.Duration
+	if !data.KeepUsedWithin.IsNull() {
+		duration, err := parseDuration(data.KeepUsedWithin.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("keep_used_within"), "Invalid duration", err.Error())
+			return nil, diags
+		}
+		keepUsedWithin = duration
+	}
+
+	return &sdk.AppRetentionInput{
+		Name:           data.Name.ValueString(),
+		Project:        data.Project.ValueString(),
+		Target:         data.Target.ValueString(),
+		KeepLatest:     int(data.KeepLatest.ValueInt64()),
+		KeepUsedWithin: keepUsedWithin,
+		Versions:       versions,
+	}, diags
+}
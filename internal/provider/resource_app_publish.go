@@ -18,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &HiveAppPublishResource{}
 var _ resource.ResourceWithImportState = &HiveAppPublishResource{}
+var _ resource.ResourceWithModifyPlan = &HiveAppPublishResource{}
 
 // NewHiveAppPublishResource is a helper function to simplify the provider implementation.
 func NewHiveAppPublishResource() resource.Resource {
@@ -90,6 +91,25 @@ func (r *HiveAppPublishResource) Configure(ctx context.Context, req resource.Con
 	r.client = client
 }
 
+// ModifyPlan rejects provider defaults that only partially select a target,
+// and warns when the token lacks the scope to apply the resource.
+func (r *HiveAppPublishResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// The app is always published on the default target of the provider.
+	resp.Diagnostics.Append(validateTarget(r.client, types.StringNull(), types.StringNull())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.Plan.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.Append(checkScope(r.client, types.StringNull(), types.StringNull(), sdk.ScopeRegistryWrite, "activate app deployments")...)
+	}
+}
+
 // Create handles the creation of the resource.
 func (r *HiveAppPublishResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data HiveAppPublishResourceModel
@@ -160,7 +180,7 @@ func (r *HiveAppPublishResource) ExecuteRequest(ctx context.Context, data *HiveA
 	})
 
 	if err != nil {
-		d := diag.NewErrorDiagnostic("App creation failed", err.Error())
+		d := newErrorDiagnostic("App creation failed", err)
 		return &d
 	}
 
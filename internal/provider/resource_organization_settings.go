@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HiveOrganizationSettingsResource{}
+var _ resource.ResourceWithImportState = &HiveOrganizationSettingsResource{}
+var _ resource.ResourceWithModifyPlan = &HiveOrganizationSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &HiveOrganizationSettingsResource{}
+
+// NewHiveOrganizationSettingsResource is a helper function to simplify the provider implementation.
+func NewHiveOrganizationSettingsResource() resource.Resource {
+	return &HiveOrganizationSettingsResource{}
+}
+
+// HiveOrganizationSettingsResource defines the resource implementation.
+type HiveOrganizationSettingsResource struct {
+	client *sdk.HiveClient
+}
+
+// HiveOrganizationSettingsResourceModel describes the resource data model.
+type HiveOrganizationSettingsResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Slug                   types.String `tfsdk:"slug"`
+	MonthlyOperationsLimit types.Int64  `tfsdk:"monthly_operations_limit"`
+}
+
+// Metadata returns the resource type name.
+func (r *HiveOrganizationSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_settings"
+}
+
+// Schema defines the schema for the hive_organization_settings resource.
+func (r *HiveOrganizationSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Resource to manage the settings of the organization of the provider. " +
+			"The settings are kept when the resource is destroyed. " +
+			"The slug can only be changed once the resource is created, the `slug` must be the `organization` of the provider when creating it. " +
+			"After changing the slug, update the `organization` of the provider to the new slug as well.",
+
+		Attributes: map[string]schema.Attribute{
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The organization slug, the current slug is kept when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monthly_operations_limit": schema.Int64Attribute{
+				MarkdownDescription: "The number of operations that are collected per month, the current limit is kept when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The organization ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure saves the provider configured HTTP client on the resource.
+func (r *HiveOrganizationSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*sdk.HiveClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *sdk.HiveClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig checks the organization settings.
+func (r *HiveOrganizationSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data HiveOrganizationSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Slug.IsUnknown() && !data.Slug.IsNull() && data.Slug.ValueString() == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("slug"), "Invalid slug", "The slug can't be empty.")
+	}
+
+	if !data.MonthlyOperationsLimit.IsUnknown() && !data.MonthlyOperationsLimit.IsNull() && data.MonthlyOperationsLimit.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("monthly_operations_limit"), "Invalid limit", "The limit can't be negative.")
+	}
+}
+
+// ModifyPlan warns when the token lacks the scope to apply the resource, and
+// rejects creating it with a slug other than the organization of the provider.
+func (r *HiveOrganizationSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	if req.State.Raw.IsNull() && r.client != nil && r.client.Organization != "" {
+		var slug types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("slug"), &slug)...)
+		if !slug.IsUnknown() && !slug.IsNull() && slug.ValueString() != r.client.Organization {
+			resp.Diagnostics.Append(slugChangedOnCreate(path.Root("slug"), r.client.Organization))
+		}
+	}
+
+	if !req.Plan.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.Append(checkScope(r.client, types.StringNull(), types.StringNull(), sdk.ScopeOrganizationSettings, "change the organization settings")...)
+	}
+}
+
+// Create handles the creation of the resource.
+func (r *HiveOrganizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HiveOrganizationSettingsResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The settings of the organization of the provider are managed. Renaming
+	// it here would leave the organization of the provider stale, so the slug
+	// can only be changed on update. The organization is looked up as the
+	// provider may get it from the token.
+	if !data.Slug.IsUnknown() && !data.Slug.IsNull() {
+		organization, err := r.client.GetOrganization(ctx, &sdk.GetOrganizationInput{})
+		if err != nil {
+			resp.Diagnostics.Append(newErrorDiagnostic("Reading organization failed", err))
+			return
+		}
+		if data.Slug.ValueString() != organization.Slug {
+			resp.Diagnostics.Append(slugChangedOnCreate(path.Root("slug"), organization.Slug))
+			return
+		}
+	}
+
+	applied, diags := r.ExecuteRequest(ctx, nil, &data)
+	resp.Diagnostics.Append(diags...)
+	if !applied {
+		return
+	}
+
+	// Save the data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *HiveOrganizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HiveOrganizationSettingsResourceModel
+
+	// Retrieve state.
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, err := r.client.GetOrganization(ctx, &sdk.GetOrganizationInput{
+		Organization: data.Slug.ValueString(),
+	})
+	// A missing organization is an error, as it is likely renamed outside of
+	// Terraform and creating the resource again wouldn't bring it back.
+	if err != nil {
+		resp.Diagnostics.Append(newErrorDiagnostic("Reading organization failed", err))
+		return
+	}
+
+	data.Id = types.StringValue(organization.Id)
+	data.Slug = types.StringValue(organization.Slug)
+	data.MonthlyOperationsLimit = types.Int64Value(organization.MonthlyOperationsLimit)
+
+	// Save any updates back to state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update handles updates to the resource.
+func (r *HiveOrganizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state HiveOrganizationSettingsResourceModel
+
+	// Read Terraform plan data into the model.
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	applied, diags := r.ExecuteRequest(ctx, &state, &data)
+	resp.Diagnostics.Append(diags...)
+	if !applied {
+		return
+	}
+
+	// Save updated data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete handles resource deletion. The organization keeps its settings, so
+// this is a no-op for this resource.
+func (r *HiveOrganizationSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// ImportState allows the resource to be imported into Terraform using the
+// organization slug.
+func (r *HiveOrganizationSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("slug"), req, resp)
+}
+
+// ExecuteRequest applies the changed settings to the organization in the
+// state, or to the organization of the provider when there is no state yet,
+// and updates data with the result. It reports whether data holds the settings
+// of the organization, which is also the case when the slug was changed but
+// changing the limit failed.
+func (r *HiveOrganizationSettingsResource) ExecuteRequest(ctx context.Context, state *HiveOrganizationSettingsResourceModel, data *HiveOrganizationSettingsResourceModel) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	input := &sdk.UpdateOrganizationInput{
+		Slug: data.Slug.ValueString(),
+	}
+	if state != nil {
+		input.Organization = state.Slug.ValueString()
+	}
+
+	// Only change the limit when it differs, as changing it may require more
+	// permissions than reading it.
+	if !data.MonthlyOperationsLimit.IsUnknown() && !data.MonthlyOperationsLimit.IsNull() &&
+		(state == nil || !data.MonthlyOperationsLimit.Equal(state.MonthlyOperationsLimit)) {
+		input.MonthlyOperationsLimit = data.MonthlyOperationsLimit.ValueInt64Pointer()
+	}
+
+	organization, err := r.client.UpdateOrganization(ctx, input)
+	if err != nil {
+		diags.Append(newErrorDiagnostic("Updating organization settings failed", err))
+	}
+	if organization == nil {
+		return false, diags
+	}
+
+	data.Id = types.StringValue(organization.Id)
+	data.Slug = types.StringValue(organization.Slug)
+	data.MonthlyOperationsLimit = types.Int64Value(organization.MonthlyOperationsLimit)
+
+	return true, diags
+}
+
+// slugChangedOnCreate returns the error for creating the resource with a slug
+// other than the organization of the provider.
+func slugChangedOnCreate(attribute path.Path, organization string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		attribute,
+		"Slug can't be changed on create",
+		fmt.Sprintf("The slug must be %q, the organization of the provider, when creating the resource. "+
+			"Change the slug after the resource is created, and update the organization of the provider along with it.", organization),
+	)
+}
@@ -18,6 +18,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &HiveSchemaCheckResource{}
 var _ resource.ResourceWithImportState = &HiveSchemaCheckResource{}
+var _ resource.ResourceWithModifyPlan = &HiveSchemaCheckResource{}
 
 // NewHiveSchemaCheckResource is a helper function to simplify the provider implementation.
 func NewHiveSchemaCheckResource() resource.Resource {
@@ -88,14 +89,14 @@ func (r *HiveSchemaCheckResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"project": schema.StringAttribute{
-				MarkdownDescription: "The project name",
+				MarkdownDescription: "The project name, defaults to the `project` of the provider",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"target": schema.StringAttribute{
-				MarkdownDescription: "The target name",
+				MarkdownDescription: "The target name, defaults to the `target` of the provider",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -130,6 +131,30 @@ func (r *HiveSchemaCheckResource) Configure(ctx context.Context, req resource.Co
 	r.client = client
 }
 
+// ModifyPlan rejects a project and target that only partially select a target,
+// and warns when the token lacks the scope to apply the resource.
+func (r *HiveSchemaCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan HiveSchemaCheckResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateTarget(r.client, plan.Project, plan.Target)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.Plan.Raw.Equal(req.State.Raw) {
+		resp.Diagnostics.Append(checkScope(r.client, plan.Project, plan.Target, sdk.ScopeRegistryRead, "check schemas")...)
+	}
+}
+
 // Create handles the creation of the resource.
 func (r *HiveSchemaCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data HiveSchemaCheckResourceModel
@@ -205,7 +230,7 @@ func (r *HiveSchemaCheckResource) ExecuteRequest(ctx context.Context, data *Hive
 	})
 
 	if err != nil {
-		d := diag.NewErrorDiagnostic("Schema check failed", err.Error())
+		d := newErrorDiagnostic("Schema check failed", err)
 		return &d
 	}
 
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-hive/internal/sdk"
+)
+
+// parseDuration parses a positive Go duration string, with additional support
+// for a number of days such as "30d".
+func parseDuration(value string) (time.Duration, error) {
+	var duration time.Duration
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		duration = time.Duration(n) * 24 * time.Hour
+	} else {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, err
+		}
+		duration = d
+	}
+
+	if duration <= 0 {
+		return 0, fmt.Errorf("duration %q must be positive", value)
+	}
+	return duration, nil
+}
+
+// usagePeriod returns the start and end of the period to read the usage of,
+// based on the period, from and to attributes. The period defaults to the last
+// 30 days. Unknown values are validated once they are known.
+func usagePeriod(period types.String, from types.String, to types.String) (time.Time, time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !period.IsNull() && !from.IsNull() {
+		diags.AddAttributeError(path.Root("from"), "Conflicting periods", "Only one of period and from can be set.")
+	}
+	if !to.IsNull() && from.IsNull() {
+		diags.AddAttributeError(path.Root("to"), "Missing start of period", "The from attribute must be set when to is set.")
+	}
+
+	end := time.Now()
+	if !to.IsNull() && !to.IsUnknown() {
+		t, err := time.Parse(time.RFC3339, to.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("to"), "Invalid time", err.Error())
+		}
+		end = t
+	}
+
+	if !from.IsNull() {
+		if from.IsUnknown() {
+			return time.Time{}, end, diags
+		}
+		start, err := time.Parse(time.RFC3339, from.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("from"), "Invalid time", err.Error())
+		} else if !start.Before(end) {
+			diags.AddAttributeError(path.Root("from"), "Invalid period", "The from time must be before the to time.")
+		}
+		return start, end, diags
+	}
+
+	value := "30d"
+	if !period.IsNull() {
+		if period.IsUnknown() {
+			return time.Time{}, end, diags
+		}
+		value = period.ValueString()
+	}
+
+	duration, err := parseDuration(value)
+	if err != nil {
+		diags.AddAttributeError(path.Root("period"), "Invalid duration", err.Error())
+	}
+	return end.Add(-duration), end, diags
+}
+
+// firstNonEmpty returns the first value that isn't empty.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// validateTarget adds an error when the project and target, combined with the
+// provider defaults, only partially select a target. Unknown values are
+// validated once they are known.
+func validateTarget(client *sdk.HiveClient, project types.String, target types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || project.IsUnknown() || target.IsUnknown() {
+		return diags
+	}
+
+	if err := client.ValidateTarget(project.ValueString(), target.ValueString()); err != nil {
+		diags.AddError("Incomplete target selector", err.Error())
+	}
+	return diags
+}
+
+// validateProject adds an error when the project, combined with the provider
+// defaults, only partially selects a project. Unknown values are validated
+// once they are known.
+func validateProject(client *sdk.HiveClient, project types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || project.IsUnknown() {
+		return diags
+	}
+
+	if err := client.ValidateProject(project.ValueString()); err != nil {
+		diags.AddError("Incomplete project selector", err.Error())
+	}
+	return diags
+}
+
+// checkScope adds a warning when the token is known to lack the scope that is
+// needed for the operation on the target.
+func checkScope(client *sdk.HiveClient, project types.String, target types.String, scope string, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if client == nil || project.IsUnknown() || target.IsUnknown() {
+		return diags
+	}
+
+	if client.MissingScope(project.ValueString(), target.ValueString(), scope) {
+		diags.AddWarning(
+			"Missing token scope",
+			fmt.Sprintf("The token doesn't have the %s scope, which is needed to %s. The request is likely to fail.", scope, operation),
+		)
+	}
+	return diags
+}
+
+// newErrorDiagnostic returns the diagnostic for an error returned by the SDK,
+// with a hint on how to resolve it based on the kind of error.
+func newErrorDiagnostic(summary string, err error) diag.ErrorDiagnostic {
+	detail := err.Error()
+
+	switch sdk.ErrorKindOf(err) {
+	case sdk.ErrorKindAuth:
+		detail += "\n\nCheck that the token is valid and has access to the target."
+	case sdk.ErrorKindNotFound:
+		detail += "\n\nThe object doesn't exist in Hive, or the token can't access it."
+	case sdk.ErrorKindValidation:
+		detail += "\n\nHive rejected the input, correct the configuration and try again."
+	case sdk.ErrorKindTransient:
+		detail += "\n\nHive is temporarily unavailable and the request kept failing after retrying. " +
+			"Try again later, or increase max_retries of the provider."
+	}
+
+	return diag.NewErrorDiagnostic(summary, detail)
+}
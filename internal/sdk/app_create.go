@@ -2,7 +2,6 @@ package sdk
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/davecgh/go-spew/spew"
@@ -16,6 +15,12 @@ type CreateAppInput struct {
 	Name      string
 	Version   string
 	Documents string
+	Project   string
+	Target    string
+
+	// HashMode is one of HashModes, defaults to trusting the given hashes.
+	HashMode      string
+	HashAlgorithm string
 }
 
 type CreateAppResult struct {
@@ -25,49 +30,76 @@ type CreateAppResult struct {
 	Status     string
 }
 
-func parseDocuments(documents string) ([]client.DocumentInput, error) {
-	operations := map[string]string{}
-	err := json.Unmarshal([]byte(documents), &operations)
-	if err != nil {
-		return nil, err
-	}
-
-	result := make([]client.DocumentInput, 0, len(operations))
-	for key, value := range operations {
-		result = append(result, client.DocumentInput{
-			Body: value,
-			Hash: key,
-		})
-	}
-
-	return result, nil
-}
-
 /**
  * CreateApp() first creates a new app version and then pushes all docucuments
- * (batched) to the new version.
+ * (batched) to the new version. When only pushing the documents fails, the
+ * created app version is returned along with the error.
  */
 func (hc *HiveClient) CreateApp(ctx context.Context, input *CreateAppInput) (*CreateAppResult, error) {
-	documents, err := parseDocuments(input.Documents)
+	documents, err := parseDocuments(input.Documents, input.HashMode, input.HashAlgorithm)
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal documents: %w", err)
+		return nil, fmt.Errorf("failed to parse documents: %w", err)
 	}
 
 	if len(documents) == 0 {
-		return nil, fmt.Errorf("no operations found in documents")
+		return nil, newError(ErrorKindValidation, "no operations found in documents")
+	}
+
+	target, err := hc.getTarget(input.Project, input.Target)
+	if err != nil {
+		return nil, err
 	}
 
 	data, err := client.CreateAppDeployment(ctx, *hc.client, client.CreateAppDeploymentInput{
 		AppName:    input.Name,
 		AppVersion: input.Version,
+		Target:     target,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, wrapRequestError(err)
 	}
 
 	if data.CreateAppDeployment.GetError() != nil {
-		return nil, fmt.Errorf("failed to create app: %s", data.CreateAppDeployment.GetError().Message)
+		return nil, newResultError("failed to create app: %s", data.CreateAppDeployment.GetError().Message)
+	}
+
+	result := CreateAppResult{
+		Id:         data.CreateAppDeployment.GetOk().CreatedAppDeployment.GetId(),
+		AppName:    data.CreateAppDeployment.GetOk().CreatedAppDeployment.GetName(),
+		AppVersion: data.CreateAppDeployment.GetOk().CreatedAppDeployment.GetVersion(),
+		Status:     string(data.CreateAppDeployment.GetOk().CreatedAppDeployment.GetStatus()),
+	}
+
+	err = hc.addDocuments(ctx, input, documents)
+	if err != nil {
+		return &result, err
+	}
+
+	return &result, nil
+}
+
+/**
+ * AddAppDocuments() pushes all documents (batched) to an existing app version
+ * that has not been activated yet.
+ */
+func (hc *HiveClient) AddAppDocuments(ctx context.Context, input *CreateAppInput) error {
+	documents, err := parseDocuments(input.Documents, input.HashMode, input.HashAlgorithm)
+	if err != nil {
+		return fmt.Errorf("failed to parse documents: %w", err)
+	}
+
+	if len(documents) == 0 {
+		return newError(ErrorKindValidation, "no operations found in documents")
+	}
+
+	return hc.addDocuments(ctx, input, documents)
+}
+
+func (hc *HiveClient) addDocuments(ctx context.Context, input *CreateAppInput, documents []client.DocumentInput) error {
+	target, err := hc.getTarget(input.Project, input.Target)
+	if err != nil {
+		return err
 	}
 
 	for _, batch := range pie.Chunk(documents, 100) {
@@ -76,10 +108,11 @@ func (hc *HiveClient) CreateApp(ctx context.Context, input *CreateAppInput) (*Cr
 			AppName:    input.Name,
 			AppVersion: input.Version,
 			Documents:  batch,
+			Target:     target,
 		})
 
 		if err != nil {
-			return nil, err
+			return wrapRequestError(err)
 		}
 
 		result := data.GetAddDocumentsToAppDeployment()
@@ -88,19 +121,12 @@ func (hc *HiveClient) CreateApp(ctx context.Context, input *CreateAppInput) (*Cr
 
 			// Skip this error for now. Need to investigate this further.
 			if result.Error.Message != "App deployment has already been activated and is locked for modifications" {
-				return nil, fmt.Errorf("failed to add documents: %s", result.Error.Message)
+				return newResultError("failed to add documents: %s", result.Error.Message)
 			} else {
 				tflog.Debug(ctx, spew.Sdump(result))
 			}
 		}
 	}
 
-	result := CreateAppResult{
-		Id:         data.CreateAppDeployment.GetOk().CreatedAppDeployment.GetId(),
-		AppName:    data.CreateAppDeployment.GetOk().CreatedAppDeployment.GetName(),
-		AppVersion: data.CreateAppDeployment.GetOk().CreatedAppDeployment.GetVersion(),
-		Status:     string(data.CreateAppDeployment.GetOk().CreatedAppDeployment.GetStatus()),
-	}
-
-	return &result, nil
+	return nil
 }
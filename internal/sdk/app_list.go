@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+// appDeploymentsPageSize is the number of app deployments requested per page.
+const appDeploymentsPageSize = 50
+
+type ListAppsInput struct {
+	Project string
+	Target  string
+}
+
+type AppDeployment struct {
+	Id                 string
+	AppName            string
+	AppVersion         string
+	Status             string
+	TotalDocumentCount int
+	LastUsed           *time.Time
+}
+
+type ListAppsResult struct {
+	TargetId       string
+	AppDeployments []AppDeployment
+}
+
+/**
+ * ListApps() pages through all app deployments of the target.
+ */
+func (hc *HiveClient) ListApps(ctx context.Context, input *ListAppsInput) (*ListAppsResult, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	result := ListAppsResult{}
+
+	var after *string
+	for {
+		data, err := client.AppDeployments(ctx, *hc.client, *selector, after, appDeploymentsPageSize)
+		if err != nil {
+			return nil, wrapRequestError(err)
+		}
+
+		if data.Target == nil {
+			return nil, newError(ErrorKindNotFound, "target %s/%s/%s not found", selector.OrganizationSlug, selector.ProjectSlug, selector.TargetSlug)
+		}
+
+		result.TargetId = data.Target.GetId()
+
+		connection := data.Target.AppDeployments
+		if connection == nil {
+			break
+		}
+
+		for _, edge := range connection.Edges {
+			node := edge.GetNode()
+			result.AppDeployments = append(result.AppDeployments, AppDeployment{
+				Id:                 node.GetId(),
+				AppName:            node.GetName(),
+				AppVersion:         node.GetVersion(),
+				Status:             string(node.GetStatus()),
+				TotalDocumentCount: node.GetTotalDocumentCount(),
+				LastUsed:           node.GetLastUsed(),
+			})
+		}
+
+		if !connection.PageInfo.HasNextPage {
+			break
+		}
+
+		cursor := connection.PageInfo.EndCursor
+		after = &cursor
+	}
+
+	return &result, nil
+}
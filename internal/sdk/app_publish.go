@@ -2,7 +2,6 @@ package sdk
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/labd/terraform-provider-hive/internal/client"
 )
@@ -11,6 +10,8 @@ type PublishAppInput struct {
 	Name      string
 	Version   string
 	Documents string
+	Project   string
+	Target    string
 }
 
 type PublishAppResult struct {
@@ -18,24 +19,30 @@ type PublishAppResult struct {
 	AppName    string
 	AppVersion string
 	Status     string
+	IsSkipped  bool
 }
 
 /**
  * PublishApp() activates the app deployment of the given name and version.
  */
 func (hc *HiveClient) PublishApp(ctx context.Context, input *PublishAppInput) (*PublishAppResult, error) {
+	target, err := hc.getTarget(input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
 
 	data, err := client.ActivateAppDeployment(ctx, *hc.client, client.ActivateAppDeploymentInput{
 		AppName:    input.Name,
 		AppVersion: input.Version,
+		Target:     target,
 	})
 
 	if err != nil {
-		return nil, err
+		return nil, wrapRequestError(err)
 	}
 
 	if data.ActivateAppDeployment.GetError() != nil {
-		return nil, fmt.Errorf("failed to create app: %s", data.ActivateAppDeployment.GetError().Message)
+		return nil, newResultError("failed to activate app deployment: %s", data.ActivateAppDeployment.GetError().Message)
 	}
 
 	result := PublishAppResult{
@@ -43,6 +50,7 @@ func (hc *HiveClient) PublishApp(ctx context.Context, input *PublishAppInput) (*
 		AppName:    data.ActivateAppDeployment.GetOk().ActivatedAppDeployment.GetName(),
 		AppVersion: data.ActivateAppDeployment.GetOk().ActivatedAppDeployment.GetVersion(),
 		Status:     string(data.ActivateAppDeployment.GetOk().ActivatedAppDeployment.GetStatus()),
+		IsSkipped:  data.ActivateAppDeployment.GetOk().IsSkipped,
 	}
 
 	return &result, nil
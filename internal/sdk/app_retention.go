@@ -0,0 +1,168 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-version"
+)
+
+type AppRetentionInput struct {
+	Name    string
+	Project string
+	Target  string
+
+	// KeepLatest is the number of most recent active versions to keep.
+	KeepLatest int
+	// KeepUsedWithin additionally keeps the active versions that were used
+	// within this duration, when set.
+	KeepUsedWithin time.Duration
+	// Versions orders the versions of the app from oldest to newest. When
+	// empty, the versions are ordered as semantic versions.
+	Versions []string
+}
+
+type AppRetentionResult struct {
+	TargetId string
+	// Retire contains the active versions that fall outside of the retention.
+	Retire []AppDeployment
+}
+
+/**
+ * AppRetention() returns the active versions of the app that fall outside of
+ * the retention. Hive doesn't return the app deployments in a guaranteed
+ * order, so the most recent versions are determined by sorting the versions.
+ * Nothing is retired when the order of the active versions can't be
+ * determined.
+ */
+func (hc *HiveClient) AppRetention(ctx context.Context, input *AppRetentionInput) (*AppRetentionResult, error) {
+	apps, err := hc.ListApps(ctx, &ListAppsInput{
+		Project: input.Project,
+		Target:  input.Target,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	active := []AppDeployment{}
+	for _, app := range apps.AppDeployments {
+		if app.AppName == input.Name && app.Status == "active" {
+			active = append(active, app)
+		}
+	}
+
+	if err := sortAppVersions(active, input.Versions); err != nil {
+		return nil, err
+	}
+
+	result := AppRetentionResult{
+		TargetId: apps.TargetId,
+		Retire:   []AppDeployment{},
+	}
+
+	usedSince := time.Now().Add(-input.KeepUsedWithin)
+	for i, app := range active {
+		if i < input.KeepLatest {
+			continue
+		}
+
+		if input.KeepUsedWithin > 0 && app.LastUsed != nil && app.LastUsed.After(usedSince) {
+			continue
+		}
+
+		result.Retire = append(result.Retire, app)
+	}
+
+	return &result, nil
+}
+
+// sortAppVersions sorts the app deployments newest first, by the position of
+// their version in order, or as semantic versions when order is empty. An
+// error is returned when the order of a version can't be determined.
+func sortAppVersions(apps []AppDeployment, order []string) error {
+	if len(order) > 0 {
+		positions := map[string]int{}
+		for i, v := range order {
+			positions[v] = i
+		}
+
+		missing := []string{}
+		for _, app := range apps {
+			if _, ok := positions[app.AppVersion]; !ok {
+				missing = append(missing, app.AppVersion)
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf("the order of the active versions %s can't be determined, add them to the versions", strings.Join(missing, ", "))
+		}
+
+		sort.SliceStable(apps, func(i, j int) bool {
+			return positions[apps[i].AppVersion] > positions[apps[j].AppVersion]
+		})
+		return nil
+	}
+
+	versions := map[string]*version.Version{}
+	invalid := []string{}
+	for _, app := range apps {
+		v, err := version.NewSemver(app.AppVersion)
+		if err != nil {
+			invalid = append(invalid, app.AppVersion)
+			continue
+		}
+		versions[app.AppVersion] = v
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("the active versions %s aren't semantic versions, so their order can't be determined. "+
+			"Set the versions ordered from oldest to newest instead", strings.Join(invalid, ", "))
+	}
+
+	sort.SliceStable(apps, func(i, j int) bool {
+		return versions[apps[i].AppVersion].GreaterThan(versions[apps[j].AppVersion])
+	})
+
+	for i := 1; i < len(apps); i++ {
+		if versions[apps[i-1].AppVersion].Equal(versions[apps[i].AppVersion]) {
+			return fmt.Errorf("the active versions %s and %s are the same semantic version, so their order can't be determined. "+
+				"Set the versions ordered from oldest to newest instead", apps[i-1].AppVersion, apps[i].AppVersion)
+		}
+	}
+	return nil
+}
+
+/**
+ * RetireApps() retires the given versions of the app, skipping the versions
+ * that are no longer active.
+ */
+func (hc *HiveClient) RetireApps(ctx context.Context, input *AppRetentionInput, versions []string) error {
+	apps, err := hc.ListApps(ctx, &ListAppsInput{
+		Project: input.Project,
+		Target:  input.Target,
+	})
+	if err != nil {
+		return err
+	}
+
+	active := map[string]bool{}
+	for _, app := range apps.AppDeployments {
+		if app.AppName == input.Name && app.Status == "active" {
+			active[app.AppVersion] = true
+		}
+	}
+
+	for _, version := range versions {
+		if !active[version] {
+			continue
+		}
+
+		_, err := hc.retireApp(ctx, apps.TargetId, input.Name, version)
+		if err != nil {
+			return fmt.Errorf("failed to retire %s@%s: %w", input.Name, version, err)
+		}
+	}
+
+	return nil
+}
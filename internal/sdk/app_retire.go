@@ -0,0 +1,128 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+// ErrAppNotFound is returned when the target exists, but the app deployment
+// doesn't.
+var ErrAppNotFound = errors.New("app deployment not found")
+
+type GetAppInput struct {
+	Name    string
+	Version string
+	Project string
+	Target  string
+}
+
+type GetAppResult struct {
+	Id         string
+	TargetId   string
+	AppName    string
+	AppVersion string
+	Status     string
+}
+
+type RetireAppInput struct {
+	Name    string
+	Version string
+	Project string
+	Target  string
+}
+
+type RetireAppResult struct {
+	Id         string
+	AppName    string
+	AppVersion string
+	Status     string
+}
+
+/**
+ * GetApp() returns the app deployment of the given name and version, or nil
+ * when it doesn't exist. A not found error is returned when the target
+ * doesn't exist, or when the token can't access it.
+ */
+func (hc *HiveClient) GetApp(ctx context.Context, input *GetAppInput) (*GetAppResult, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.AppDeployment(ctx, *hc.client, *selector, input.Name, input.Version)
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	if data.Target == nil {
+		return nil, newError(ErrorKindNotFound, "target %s/%s/%s not found", selector.OrganizationSlug, selector.ProjectSlug, selector.TargetSlug)
+	}
+
+	if data.Target.AppDeployment == nil {
+		return nil, nil
+	}
+
+	result := GetAppResult{
+		Id:         data.Target.AppDeployment.GetId(),
+		TargetId:   data.Target.GetId(),
+		AppName:    data.Target.AppDeployment.GetName(),
+		AppVersion: data.Target.AppDeployment.GetVersion(),
+		Status:     string(data.Target.AppDeployment.GetStatus()),
+	}
+
+	return &result, nil
+}
+
+/**
+ * RetireApp() retires the app deployment of the given name and version. The
+ * persisted documents of a retired app deployment are no longer served.
+ */
+func (hc *HiveClient) RetireApp(ctx context.Context, input *RetireAppInput) (*RetireAppResult, error) {
+	app, err := hc.GetApp(ctx, &GetAppInput{
+		Name:    input.Name,
+		Version: input.Version,
+		Project: input.Project,
+		Target:  input.Target,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if app == nil {
+		return nil, &Error{
+			Kind:    ErrorKindNotFound,
+			Message: fmt.Sprintf("%s: %s@%s", ErrAppNotFound, input.Name, input.Version),
+			Err:     ErrAppNotFound,
+		}
+	}
+
+	return hc.retireApp(ctx, app.TargetId, input.Name, input.Version)
+}
+
+func (hc *HiveClient) retireApp(ctx context.Context, targetId string, name string, version string) (*RetireAppResult, error) {
+	data, err := client.RetireAppDeployment(ctx, *hc.client, client.RetireAppDeploymentInput{
+		AppName:    name,
+		AppVersion: version,
+		TargetId:   targetId,
+	})
+
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	if data.RetireAppDeployment.GetError() != nil {
+		return nil, newResultError("failed to retire app: %s", data.RetireAppDeployment.GetError().Message)
+	}
+
+	result := RetireAppResult{
+		Id:         data.RetireAppDeployment.GetOk().RetiredAppDeployment.GetId(),
+		AppName:    data.RetireAppDeployment.GetOk().RetiredAppDeployment.GetName(),
+		AppVersion: data.RetireAppDeployment.GetOk().RetiredAppDeployment.GetVersion(),
+		Status:     string(data.RetireAppDeployment.GetOk().RetiredAppDeployment.GetStatus()),
+	}
+
+	return &result, nil
+}
@@ -1,11 +1,19 @@
 package sdk
 
 import (
+	"crypto/rand"
+	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 
 	"github.com/Khan/genqlient/graphql"
 )
 
+// RequestIdHeader is the header with the ID that correlates a request with the
+// provider logs and the Hive server logs.
+const RequestIdHeader = "X-Request-Id"
+
 // HiveRoundTripper wraps an underlying RoundTripper.
 type HiveRoundTripper struct {
 	rt        http.RoundTripper
@@ -13,25 +21,62 @@ type HiveRoundTripper struct {
 	userAgent string
 }
 
-// RoundTrip adds the Authorization and User-Agent headers to every request.
+// RoundTrip adds the Authorization, User-Agent and request ID headers to every
+// request. The request ID is kept when the request is retried.
 func (hrt *HiveRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
 	req.Header.Set("Authorization", "Bearer "+hrt.authToken)
 	req.Header.Set("User-Agent", hrt.userAgent)
+	if req.Header.Get(RequestIdHeader) == "" {
+		req.Header.Set(RequestIdHeader, newRequestId())
+	}
 	return hrt.rt.RoundTrip(req)
 }
 
+// UserAgent returns the User-Agent for the given provider and Terraform
+// versions, with an optional suffix appended.
+func UserAgent(providerVersion string, terraformVersion string, suffix string) string {
+	parts := []string{fmt.Sprintf("terraform-provider-hive/%s", providerVersion)}
+	if terraformVersion != "" {
+		parts = append(parts, fmt.Sprintf("Terraform/%s", terraformVersion))
+	}
+	if suffix = strings.TrimSpace(suffix); suffix != "" {
+		parts = append(parts, suffix)
+	}
+	return strings.Join(parts, " ")
+}
+
+// newRequestId returns a random version 4 UUID.
+func newRequestId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
 // HiveClient encapsulates an HTTP client, a GraphQL endpoint, an API token and an optional Organization string.
 type HiveClient struct {
 	client       *graphql.Client
 	Organization string
+	// Project and Target are the defaults for requests that don't specify a
+	// project or target.
+	Project string
+	Target  string
+
+	// tokenInfo is the cached token info, see LoadTokenInfo. It is guarded
+	// by tokenInfoMu, as the client is shared by all resources and data
+	// sources.
+	tokenInfo   *TokenInfo
+	tokenInfoMu sync.Mutex
 }
 
 // NewHiveClient creates a new HiveClient instance.
-func NewHiveClient(client *http.Client, endpoint, organization string, token string) *HiveClient {
+func NewHiveClient(client *http.Client, endpoint, organization, project, target string, token string, userAgent string) *HiveClient {
 	client.Transport = &HiveRoundTripper{
 		rt:        client.Transport,
 		authToken: token,
-		userAgent: "terraform-provider-hive/0.0.1",
+		userAgent: userAgent,
 	}
 
 	gqlClient := graphql.NewClient(endpoint, client)
@@ -39,5 +84,7 @@ func NewHiveClient(client *http.Client, endpoint, organization string, token str
 	return &HiveClient{
 		client:       &gqlClient,
 		Organization: organization,
+		Project:      project,
+		Target:       target,
 	}
 }
@@ -0,0 +1,62 @@
+package sdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// DefaultCLIConfigFile is the file the Hive CLI reads its configuration from.
+const DefaultCLIConfigFile = "hive.json"
+
+// CLIConfig contains the registry settings of a Hive CLI configuration file.
+type CLIConfig struct {
+	Endpoint    string
+	AccessToken string
+}
+
+type cliConfigFile struct {
+	// Registry is either an object with the endpoint and access token, or the
+	// endpoint in older versions of the CLI.
+	Registry json.RawMessage `json:"registry"`
+	// Token is the access token in older versions of the CLI.
+	Token string `json:"token"`
+}
+
+type cliRegistryConfig struct {
+	Endpoint    string `json:"endpoint"`
+	AccessToken string `json:"accessToken"`
+}
+
+// ReadCLIConfig reads the registry settings from a Hive CLI configuration file
+// (hive.json), supporting both the current and the legacy format.
+func ReadCLIConfig(path string) (*CLIConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := cliConfigFile{}
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	config := CLIConfig{AccessToken: file.Token}
+	if len(file.Registry) == 0 {
+		return &config, nil
+	}
+
+	registry := cliRegistryConfig{}
+	if err := json.Unmarshal(file.Registry, &registry); err == nil {
+		config.Endpoint = registry.Endpoint
+		if registry.AccessToken != "" {
+			config.AccessToken = registry.AccessToken
+		}
+		return &config, nil
+	}
+
+	if err := json.Unmarshal(file.Registry, &config.Endpoint); err != nil {
+		return nil, fmt.Errorf("failed to parse registry in %s: %w", path, err)
+	}
+	return &config, nil
+}
@@ -1,32 +1,97 @@
 package sdk
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httputil"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func NewDebugTransport(innerTransport http.RoundTripper) http.RoundTripper {
+const (
+	// DebugLogModeFull logs the (redacted) requests and responses.
+	DebugLogModeFull = "full"
+	// DebugLogModeOperations only logs the GraphQL operation names and timings.
+	DebugLogModeOperations = "operations"
+
+	DefaultMaxLogBodySize = 16 * 1024
+
+	redacted = "REDACTED"
+)
+
+var DebugLogModes = []string{DebugLogModeFull, DebugLogModeOperations}
+
+// redactedHeaders contains the (canonical) headers whose values are never
+// logged.
+var redactedHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Api-Token",
+	"X-Hive-Cdn-Key",
+}
+
+// redactedFields contains the JSON fields whose values are never logged, such
+// as the token secrets returned by mutations.
+var redactedFields = []string{
+	"authorization",
+	"clientsecret",
+	"secret",
+	"secretaccesstoken",
+	"token",
+}
+
+// DebugOptions configures the LogTransport.
+type DebugOptions struct {
+	// Mode is one of DebugLogModes, defaults to DebugLogModeFull.
+	Mode string
+	// MaxBodySize caps the number of bytes logged per request or response
+	// body, zero disables the cap.
+	MaxBodySize int
+}
+
+func NewDebugTransport(innerTransport http.RoundTripper, options DebugOptions) http.RoundTripper {
 	return &LogTransport{
 		transport: innerTransport,
+		options:   options,
 	}
 }
 
 type LogTransport struct {
 	transport http.RoundTripper
+	options   DebugOptions
 }
 
 var DebugTransport = &LogTransport{
 	transport: http.DefaultTransport,
+	options: DebugOptions{
+		Mode:        DebugLogModeFull,
+		MaxBodySize: DefaultMaxLogBodySize,
+	},
 }
 
 func (c *LogTransport) RoundTrip(request *http.Request) (*http.Response, error) {
-	logRequest(request.Context(), request)
+	ctx := tflog.SetField(request.Context(), "request_id", request.Header.Get(RequestIdHeader))
+
+	if c.options.Mode == DebugLogModeOperations {
+		operation := operationName(request)
+		start := time.Now()
+		response, err := c.transport.RoundTrip(request)
+		logOperation(ctx, operation, time.Since(start), response, err)
+		return response, err
+	}
+
+	logRequest(ctx, request, c.options.MaxBodySize)
+	start := time.Now()
 	response, err := c.transport.RoundTrip(request)
-	logResponse(request.Context(), response, err)
+	logResponse(ctx, response, err, time.Since(start), c.options.MaxBodySize)
 	return response, err
 }
 
@@ -42,22 +107,156 @@ const logResponseTemplate = `DEBUG:
 ----------------------------------------------------------------------
 `
 
-func logRequest(ctx context.Context, r *http.Request) {
-	body, err := httputil.DumpRequestOut(r, true)
+func logRequest(ctx context.Context, r *http.Request, maxBodySize int) {
+	body, err := peekRequestBody(r)
+	if err != nil {
+		return
+	}
+
+	clone := r.Clone(ctx)
+	clone.Header = redactHeaders(r.Header)
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+
+	head, err := httputil.DumpRequestOut(clone, false)
 	if err != nil {
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf(logRequestTemplate, body))
+	tflog.Debug(ctx, fmt.Sprintf(logRequestTemplate, string(head)+formatBody(body, maxBodySize)))
 }
 
-func logResponse(ctx context.Context, r *http.Response, err error) {
+func logResponse(ctx context.Context, r *http.Response, err error, duration time.Duration, maxBodySize int) {
 	if err != nil {
 		tflog.Debug(ctx, fmt.Sprintf(logResponseTemplate, err))
 		return
 	}
-	body, err := httputil.DumpResponse(r, true)
+
+	body, err := peekResponseBody(r)
+	if err != nil {
+		return
+	}
+
+	clone := *r
+	clone.Header = redactHeaders(r.Header)
+	clone.Body = nil
+
+	head, err := httputil.DumpResponse(&clone, false)
 	if err != nil {
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf(logResponseTemplate, body))
+	tflog.Debug(ctx, fmt.Sprintf(logResponseTemplate, string(head)+formatBody(body, maxBodySize)), map[string]any{
+		"duration": duration.String(),
+	})
+}
+
+func logOperation(ctx context.Context, operation string, duration time.Duration, r *http.Response, err error) {
+	fields := map[string]any{
+		"operation": operation,
+		"duration":  duration.String(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status"] = r.StatusCode
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("GraphQL operation %s finished in %s", operation, duration), fields)
+}
+
+// operationName returns the GraphQL operation name of the request body.
+func operationName(r *http.Request) string {
+	body, err := peekRequestBody(r)
+	if err != nil {
+		return "unknown"
+	}
+
+	payload := struct {
+		OperationName string `json:"operationName"`
+	}{}
+	if json.Unmarshal(body, &payload) != nil || payload.OperationName == "" {
+		return "unknown"
+	}
+	return payload.OperationName
+}
+
+// peekRequestBody reads the request body and replaces it, so it can still be
+// sent afterwards.
+func peekRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// peekResponseBody reads the response body and replaces it, so it can still
+// be read afterwards.
+func peekResponseBody(r *http.Response) ([]byte, error) {
+	if r.Body == nil || r.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func redactHeaders(header http.Header) http.Header {
+	result := header.Clone()
+	for _, name := range redactedHeaders {
+		if result.Get(name) != "" {
+			result.Set(name, redacted)
+		}
+	}
+	return result
+}
+
+// formatBody redacts the secrets in a JSON body and caps it to the maximum
+// size. Bodies that aren't JSON are only capped.
+func formatBody(body []byte, maxBodySize int) string {
+	var payload any
+	if err := json.Unmarshal(body, &payload); err == nil {
+		if redactedBody, err := json.Marshal(redactJSON(payload)); err == nil {
+			body = redactedBody
+		}
+	}
+
+	if maxBodySize > 0 && len(body) > maxBodySize {
+		return fmt.Sprintf("%s... (%d bytes truncated)", body[:maxBodySize], len(body)-maxBodySize)
+	}
+	return string(body)
+}
+
+func redactJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if item != nil && isRedactedField(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJSON(item)
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+		return v
+	}
+	return value
+}
+
+func isRedactedField(key string) bool {
+	return slices.Contains(redactedFields, strings.ToLower(key))
 }
@@ -0,0 +1,208 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		value    string
+		redacted bool
+	}{
+		{name: "authorization", header: "Authorization", value: "Bearer secret", redacted: true},
+		{name: "cookie", header: "Cookie", value: "session=secret", redacted: true},
+		{name: "set cookie", header: "Set-Cookie", value: "session=secret", redacted: true},
+		{name: "api token", header: "X-Api-Token", value: "secret", redacted: true},
+		{name: "lower case api token", header: "x-api-token", value: "secret", redacted: true},
+		{name: "cdn key", header: "X-Hive-Cdn-Key", value: "secret", redacted: true},
+		{name: "other", header: "Content-Type", value: "application/json", redacted: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(tt.header, tt.value)
+
+			result := redactHeaders(header)
+
+			want := tt.value
+			if tt.redacted {
+				want = redacted
+			}
+			if got := result.Get(tt.header); got != want {
+				t.Errorf("got %s: %q, want %q", tt.header, got, want)
+			}
+			if header.Get(tt.header) != tt.value {
+				t.Errorf("the original header was changed")
+			}
+		})
+	}
+}
+
+func TestFormatBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		maxBodySize int
+		want        string
+	}{
+		{
+			name: "top level token",
+			body: `{"token":"secret","name":"ci"}`,
+			want: `{"name":"ci","token":"REDACTED"}`,
+		},
+		{
+			name: "nested secrets",
+			body: `{"data":{"createToken":{"ok":{"secret":"s1","createdToken":{"id":"1"}}}}}`,
+			want: `{"data":{"createToken":{"ok":{"createdToken":{"id":"1"},"secret":"REDACTED"}}}}`,
+		},
+		{
+			name: "secrets in lists",
+			body: `{"tokens":[{"token":"s1"},{"token":"s2"}]}`,
+			want: `{"tokens":[{"token":"REDACTED"},{"token":"REDACTED"}]}`,
+		},
+		{
+			name: "field names are case insensitive",
+			body: `{"clientSecret":"s1","SecretAccessToken":"s2","Authorization":"s3"}`,
+			want: `{"Authorization":"REDACTED","SecretAccessToken":"REDACTED","clientSecret":"REDACTED"}`,
+		},
+		{
+			name: "objects are redacted",
+			body: `{"token":{"value":"s1"}}`,
+			want: `{"token":"REDACTED"}`,
+		},
+		{
+			name: "numbers and lists are redacted",
+			body: `{"secret":1234,"token":["s1"]}`,
+			want: `{"secret":"REDACTED","token":"REDACTED"}`,
+		},
+		{
+			name: "null is kept",
+			body: `{"token":null}`,
+			want: `{"token":null}`,
+		},
+		{
+			name: "variables",
+			body: `{"query":"mutation { a }","variables":{"input":{"token":"s1"}}}`,
+			want: `{"query":"mutation { a }","variables":{"input":{"token":"REDACTED"}}}`,
+		},
+		{
+			name: "not JSON",
+			body: `token=secret`,
+			want: `token=secret`,
+		},
+		{
+			name:        "capped",
+			body:        `{"name":"abcdefghij"}`,
+			maxBodySize: 10,
+			want:        `{"name":"a... (11 bytes truncated)`,
+		},
+		{
+			name:        "capped after redaction",
+			body:        `{"token":"a very long secret value"}`,
+			maxBodySize: 12,
+			want:        `{"token":"RE... (8 bytes truncated)`,
+		},
+		{
+			name:        "below the cap",
+			body:        `{"name":"a"}`,
+			maxBodySize: 100,
+			want:        `{"name":"a"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatBody([]byte(tt.body), tt.maxBodySize); got != tt.want {
+				t.Errorf("formatBody(%s)\n got: %s\nwant: %s", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogTransport(t *testing.T) {
+	const requestBody = `{"operationName":"CreateToken","query":"mutation CreateToken { a }","variables":{"token":"request-secret"}}`
+	const responseBody = `{"data":{"createToken":{"secret":"response-secret"}}}`
+
+	tests := []struct {
+		name    string
+		mode    string
+		want    []string
+		notWant []string
+	}{
+		{
+			name:    "full",
+			mode:    DebugLogModeFull,
+			want:    []string{"mutation CreateToken", "Authorization: REDACTED", "X-Api-Token: REDACTED", `\"secret\":\"REDACTED\"`},
+			notWant: []string{"request-secret", "response-secret", "auth-secret", "api-secret"},
+		},
+		{
+			name:    "operations",
+			mode:    DebugLogModeOperations,
+			want:    []string{"GraphQL operation CreateToken finished", `"status":200`},
+			notWant: []string{"request-secret", "response-secret", "auth-secret", "api-secret", "mutation CreateToken", "REDACTED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// The request must still be sent in full after logging it.
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != requestBody {
+					t.Errorf("server received body %q, want %q", body, requestBody)
+				}
+				if r.Header.Get("Authorization") != "Bearer auth-secret" {
+					t.Errorf("server received Authorization %q", r.Header.Get("Authorization"))
+				}
+				_, _ = w.Write([]byte(responseBody))
+			}))
+			defer server.Close()
+
+			var output bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &output)
+
+			request, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(requestBody))
+			if err != nil {
+				t.Fatal(err)
+			}
+			request.Header.Set("Authorization", "Bearer auth-secret")
+			request.Header.Set("X-Api-Token", "api-secret")
+
+			transport := NewDebugTransport(http.DefaultTransport, DebugOptions{Mode: tt.mode, MaxBodySize: DefaultMaxLogBodySize})
+			response, err := transport.RoundTrip(request)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			defer response.Body.Close()
+
+			// The response must still be readable in full after logging it.
+			body, _ := io.ReadAll(response.Body)
+			if string(body) != responseBody {
+				t.Errorf("got response body %q, want %q", body, responseBody)
+			}
+
+			logged := output.String()
+			for _, want := range tt.want {
+				if !strings.Contains(logged, want) {
+					t.Errorf("log doesn't contain %q\n%s", want, logged)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(logged, notWant) {
+					t.Errorf("log contains %q\n%s", notWant, logged)
+				}
+			}
+		})
+	}
+}
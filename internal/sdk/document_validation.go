@@ -0,0 +1,121 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+	"github.com/vektah/gqlparser/v2/validator"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+// DocumentValidationError describes an operation that doesn't validate
+// against the schema.
+type DocumentValidationError struct {
+	Hash          string
+	OperationName string
+	Message       string
+	Line          int
+	Column        int
+}
+
+func (e DocumentValidationError) Error() string {
+	name := e.OperationName
+	if name == "" {
+		name = "<anonymous>"
+	}
+
+	if e.Line == 0 {
+		return fmt.Sprintf("document %s (%s): %s", e.Hash, name, e.Message)
+	}
+	return fmt.Sprintf("document %s (%s) at %d:%d: %s", e.Hash, name, e.Line, e.Column, e.Message)
+}
+
+// GetLatestValidSchema returns the SDL of the latest valid schema version of
+// the target. When no slugs are set the target of the token is used.
+func (hc *HiveClient) GetLatestValidSchema(ctx context.Context, project string, target string) (string, error) {
+	ref, err := hc.getTarget(project, target)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := client.LatestValidSchema(ctx, *hc.client, ref)
+	if err != nil {
+		return "", wrapRequestError(err)
+	}
+
+	if data.LatestValidVersion == nil || data.LatestValidVersion.Sdl == nil {
+		return "", newError(ErrorKindNotFound, "no valid schema version found for target")
+	}
+
+	return *data.LatestValidVersion.Sdl, nil
+}
+
+// ValidateDocumentsAgainstSchema validates every document in the documents
+// file against the given SDL.
+func ValidateDocumentsAgainstSchema(sdl string, documents string, mode string, algorithm string) ([]DocumentValidationError, error) {
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "schema.graphql", Input: sdl})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schema: %w", err)
+	}
+
+	inputs, err := parseDocuments(documents, mode, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []DocumentValidationError{}
+	for _, input := range inputs {
+		doc, parseErr := parser.ParseQuery(&ast.Source{Name: input.Hash, Input: input.Body})
+
+		var errs gqlerror.List
+		if parseErr != nil {
+			gqlErr, ok := parseErr.(*gqlerror.Error)
+			if !ok {
+				gqlErr = gqlerror.Wrap(parseErr)
+			}
+			errs = gqlerror.List{gqlErr}
+		} else {
+			errs = validator.Validate(schema, doc)
+		}
+
+		for _, e := range errs {
+			verr := DocumentValidationError{
+				Hash:          input.Hash,
+				OperationName: operationNames(doc),
+				Message:       e.Message,
+			}
+			if len(e.Locations) > 0 {
+				verr.Line = e.Locations[0].Line
+				verr.Column = e.Locations[0].Column
+			}
+			result = append(result, verr)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Hash < result[j].Hash
+	})
+
+	return result, nil
+}
+
+func operationNames(doc *ast.QueryDocument) string {
+	if doc == nil {
+		return ""
+	}
+
+	names := make([]string, 0, len(doc.Operations))
+	for _, op := range doc.Operations {
+		if op.Name != "" {
+			names = append(names, op.Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
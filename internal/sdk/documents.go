@@ -0,0 +1,355 @@
+package sdk
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+const (
+	// HashModeTrust uses the keys of the documents file as-is.
+	HashModeTrust = "trust"
+	// HashModeVerify fails when a key doesn't match the hash of its document.
+	HashModeVerify = "verify"
+	// HashModeCompute ignores the keys and computes the hashes instead.
+	HashModeCompute = "compute"
+
+	HashAlgorithmSHA256 = "sha256"
+	HashAlgorithmMD5    = "md5"
+)
+
+var HashModes = []string{HashModeTrust, HashModeVerify, HashModeCompute}
+var HashAlgorithms = []string{HashAlgorithmSHA256, HashAlgorithmMD5}
+
+// DocumentError describes a problem with a single document in a documents
+// file.
+type DocumentError struct {
+	Hash string
+	// Index is the position of the document, starting at 1, when the
+	// documents file is a list of document bodies without hashes.
+	Index   int
+	Message string
+}
+
+func (e DocumentError) Error() string {
+	if e.Index > 0 {
+		return fmt.Sprintf("document %d: %s", e.Index, e.Message)
+	}
+	return fmt.Sprintf("document %s: %s", e.Hash, e.Message)
+}
+
+// document is a single document of a documents file. Either the hash is set,
+// or the index when the documents file is a list of document bodies.
+type document struct {
+	Hash  string
+	Index int
+	Body  string
+}
+
+func (d document) error(format string, args ...any) DocumentError {
+	return DocumentError{Hash: d.Hash, Index: d.Index, Message: fmt.Sprintf(format, args...)}
+}
+
+func (d document) String() string {
+	if d.Index > 0 {
+		return fmt.Sprintf("document %d", d.Index)
+	}
+	return fmt.Sprintf("hash %s", d.Hash)
+}
+
+// normalizeDocument strips the surrounding whitespace and line ending
+// differences, to detect the same document being registered twice.
+func normalizeDocument(body string) string {
+	return strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n"))
+}
+
+// HashDocument returns the hex encoded hash of the document body exactly as
+// it is uploaded, matching the hashes generated by common GraphQL client
+// tooling.
+func HashDocument(body string, algorithm string) (string, error) {
+	switch algorithm {
+	case HashAlgorithmSHA256, "":
+		sum := sha256.Sum256([]byte(body))
+		return hex.EncodeToString(sum[:]), nil
+	case HashAlgorithmMD5:
+		sum := md5.Sum([]byte(body))
+		return hex.EncodeToString(sum[:]), nil
+	}
+
+	return "", fmt.Errorf("unsupported hash algorithm %q", algorithm)
+}
+
+// unmarshalDocuments reads a documents file as a map of hash to document
+// body, and returns the documents sorted by hash. When the hashes are
+// computed a plain list of document bodies is accepted as well, which is
+// returned in order.
+func unmarshalDocuments(documents string, mode string) ([]document, error) {
+	operations := map[string]string{}
+	err := json.Unmarshal([]byte(documents), &operations)
+	if err == nil {
+		result := make([]document, 0, len(operations))
+		for hash, body := range operations {
+			result = append(result, document{Hash: hash, Body: body})
+		}
+		sort.Slice(result, func(i, j int) bool {
+			return result[i].Hash < result[j].Hash
+		})
+		return result, nil
+	}
+	if mode != HashModeCompute {
+		return nil, err
+	}
+
+	bodies := []string{}
+	if json.Unmarshal([]byte(documents), &bodies) != nil {
+		return nil, err
+	}
+
+	result := make([]document, 0, len(bodies))
+	for i, body := range bodies {
+		result = append(result, document{Index: i + 1, Body: body})
+	}
+	return result, nil
+}
+
+// ValidateDocuments checks the hashes of all documents for the given mode and
+// algorithm, and that no document body is registered under several hashes. It
+// returns an error when the documents can't be parsed at all.
+func ValidateDocuments(documents string, mode string, algorithm string) ([]DocumentError, error) {
+	docs, err := unmarshalDocuments(documents, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	if algorithm == "" {
+		algorithm = HashAlgorithmSHA256
+	}
+
+	errs := []DocumentError{}
+	seen := map[string]document{}
+	for _, doc := range docs {
+		if mode == HashModeVerify {
+			hash, err := HashDocument(doc.Body, algorithm)
+			if err != nil {
+				return nil, err
+			}
+
+			if !strings.EqualFold(hash, doc.Hash) {
+				errs = append(errs, doc.error("hash doesn't match the document body, expected %s (%s)", hash, algorithm))
+			}
+		}
+
+		normalized := normalizeDocument(doc.Body)
+		if other, ok := seen[normalized]; ok {
+			errs = append(errs, doc.error("document body is also registered under %s", other))
+			continue
+		}
+		seen[normalized] = doc
+	}
+
+	return errs, nil
+}
+
+// parseDocuments converts a documents file into the document inputs sent to
+// Hive, sorted by hash so the batches are deterministic.
+func parseDocuments(documents string, mode string, algorithm string) ([]client.DocumentInput, error) {
+	errs, err := ValidateDocuments(documents, mode, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(errs) > 0 {
+		joined := make([]error, 0, len(errs))
+		for _, e := range errs {
+			joined = append(joined, e)
+		}
+		return nil, errors.Join(joined...)
+	}
+
+	docs, err := unmarshalDocuments(documents, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]client.DocumentInput, 0, len(docs))
+	for _, doc := range docs {
+		hash := doc.Hash
+		if mode == HashModeCompute {
+			hash, err = HashDocument(doc.Body, algorithm)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		result = append(result, client.DocumentInput{
+			Body: doc.Body,
+			Hash: hash,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Hash < result[j].Hash
+	})
+
+	return result, nil
+}
+
+// DocumentHashes returns the documents that are uploaded for the documents
+// file, as a map of hash to document body.
+func DocumentHashes(documents string, mode string, algorithm string) (map[string]string, error) {
+	inputs, err := parseDocuments(documents, mode, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]string, len(inputs))
+	for _, input := range inputs {
+		result[input.Hash] = input.Body
+	}
+	return result, nil
+}
+
+// ManifestError describes why one of the manifests passed to MergeDocuments
+// can't be merged.
+type ManifestError struct {
+	// Index is the position of the manifest.
+	Index int
+	Err   error
+}
+
+func (e ManifestError) Error() string {
+	return fmt.Sprintf("manifest %d: %s", e.Index+1, e.Err)
+}
+
+func (e ManifestError) Unwrap() error {
+	return e.Err
+}
+
+// apolloManifest is the persisted query manifest generated by the Apollo
+// client tooling.
+type apolloManifest struct {
+	Format     string `json:"format"`
+	Operations []struct {
+		Id   string `json:"id"`
+		Body string `json:"body"`
+	} `json:"operations"`
+}
+
+// unmarshalManifest reads a manifest as a map of hash to document body. Both
+// the documents file format and Apollo persisted query manifests are
+// accepted. A plain list of document bodies isn't, as it has no hashes. A
+// DocumentError is returned when the manifest uses the same hash for
+// different documents.
+func unmarshalManifest(manifest string) (map[string]string, error) {
+	operations := map[string]string{}
+	add := func(hash string, body string) error {
+		if other, ok := operations[hash]; ok && normalizeDocument(other) != normalizeDocument(body) {
+			return DocumentError{
+				Hash:    hash,
+				Message: "hash is used for different documents in the manifest",
+			}
+		}
+		operations[hash] = body
+		return nil
+	}
+
+	var apollo apolloManifest
+	if json.Unmarshal([]byte(manifest), &apollo) == nil && apollo.Format == "apollo-persisted-query-manifest" {
+		for _, operation := range apollo.Operations {
+			if err := add(operation.Id, operation.Body); err != nil {
+				return nil, err
+			}
+		}
+		return operations, nil
+	}
+
+	if json.Unmarshal([]byte(manifest), &[]string{}) == nil {
+		return nil, errors.New("a list of document bodies has no hashes, " +
+			"pass it to the documents of hive_app_create with hash_mode \"compute\" instead")
+	}
+
+	// The keys are read one by one, as decoding into a map silently keeps
+	// the last of duplicate keys.
+	decoder := json.NewDecoder(strings.NewReader(manifest))
+	err := func() error {
+		if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+			return errors.New("not a JSON object")
+		}
+		for decoder.More() {
+			token, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			var body string
+			if err := decoder.Decode(&body); err != nil {
+				return err
+			}
+			if err := add(token.(string), body); err != nil {
+				return err
+			}
+		}
+		_, err := decoder.Token()
+		return err
+	}()
+
+	var documentErr DocumentError
+	if errors.As(err, &documentErr) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("expected a map of hash to document, or an Apollo persisted query manifest: %w", err)
+	}
+	return operations, nil
+}
+
+// MergeDocuments merges manifests into a single documents file, as a map of
+// hash to document body. Documents that are in several manifests are only
+// included once, and a ManifestError is returned when the same hash is used
+// for different documents.
+func MergeDocuments(manifests []string) (string, error) {
+	merged := map[string]string{}
+
+	for i, manifest := range manifests {
+		operations, err := unmarshalManifest(manifest)
+		if err != nil {
+			return "", ManifestError{Index: i, Err: err}
+		}
+
+		hashes := make([]string, 0, len(operations))
+		for hash := range operations {
+			hashes = append(hashes, hash)
+		}
+		sort.Strings(hashes)
+
+		for _, hash := range hashes {
+			body := operations[hash]
+			other, ok := merged[hash]
+			if !ok {
+				merged[hash] = body
+				continue
+			}
+			if normalizeDocument(other) != normalizeDocument(body) {
+				return "", ManifestError{Index: i, Err: DocumentError{
+					Hash:    hash,
+					Message: "hash is already used for a different document in an earlier manifest",
+				}}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(merged); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
@@ -0,0 +1,197 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// ErrorKind classifies the errors returned by the HiveClient.
+type ErrorKind int
+
+const (
+	ErrorKindUnknown ErrorKind = iota
+	// ErrorKindAuth means the token is invalid or lacks access.
+	ErrorKindAuth
+	// ErrorKindNotFound means the requested object doesn't exist.
+	ErrorKindNotFound
+	// ErrorKindValidation means Hive rejected the input.
+	ErrorKindValidation
+	// ErrorKindTransient means the request may succeed when tried again.
+	ErrorKindTransient
+)
+
+func (k ErrorKind) String() string {
+	switch k {
+	case ErrorKindAuth:
+		return "auth"
+	case ErrorKindNotFound:
+		return "not found"
+	case ErrorKindValidation:
+		return "validation"
+	case ErrorKindTransient:
+		return "transient"
+	}
+	return "unknown"
+}
+
+// transientStatuses contains the HTTP status codes of failed requests that
+// may succeed when tried again. Hive didn't process the requests with a status
+// code that maps to true, so those can also be retried for mutations.
+var transientStatuses = map[int]bool{
+	429: true,
+	502: false,
+	503: true,
+	504: false,
+}
+
+// graphQLErrorKinds maps the extensions.code of GraphQL errors to their kind.
+var graphQLErrorKinds = map[string]ErrorKind{
+	"UNAUTHENTICATED":           ErrorKindAuth,
+	"FORBIDDEN":                 ErrorKindAuth,
+	"NOT_FOUND":                 ErrorKindNotFound,
+	"BAD_USER_INPUT":            ErrorKindValidation,
+	"GRAPHQL_PARSE_FAILED":      ErrorKindValidation,
+	"GRAPHQL_VALIDATION_FAILED": ErrorKindValidation,
+	"RATE_LIMITED":              ErrorKindTransient,
+}
+
+// Error is the error returned by the HiveClient when a request fails, or when
+// Hive returns one of the error results of a mutation.
+type Error struct {
+	Kind    ErrorKind
+	Message string
+	// StatusCode is the HTTP status code, when the request failed with one.
+	StatusCode int
+	// GraphQLErrors contains the errors of the GraphQL response, if any.
+	GraphQLErrors gqlerror.List
+	// Err is the underlying error, if any.
+	Err error
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ErrorKindOf returns the kind of the error, or ErrorKindUnknown when it isn't
+// an *Error.
+func ErrorKindOf(err error) ErrorKind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	return ErrorKindUnknown
+}
+
+func IsAuthError(err error) bool {
+	return ErrorKindOf(err) == ErrorKindAuth
+}
+
+func IsNotFoundError(err error) bool {
+	return ErrorKindOf(err) == ErrorKindNotFound
+}
+
+func IsValidationError(err error) bool {
+	return ErrorKindOf(err) == ErrorKindValidation
+}
+
+func IsTransientError(err error) bool {
+	return ErrorKindOf(err) == ErrorKindTransient
+}
+
+// newError returns an *Error of the given kind.
+func newError(kind ErrorKind, format string, args ...any) *Error {
+	return &Error{
+		Kind:    kind,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// newResultError returns an *Error for one of the error results of a
+// mutation, such as CreateAppDeploymentError. These don't have a code, so they
+// are treated as Hive rejecting the input.
+func newResultError(format string, args ...any) *Error {
+	return newError(ErrorKindValidation, format, args...)
+}
+
+// wrapRequestError classifies the error returned by a generated client
+// operation.
+func wrapRequestError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var e *Error
+	if errors.As(err, &e) {
+		return err
+	}
+
+	result := &Error{
+		Kind:    ErrorKindUnknown,
+		Message: err.Error(),
+		Err:     err,
+	}
+
+	var httpErr *graphql.HTTPError
+	var gqlErrs gqlerror.List
+	var netErr net.Error
+
+	switch {
+	case errors.As(err, &httpErr):
+		result.StatusCode = httpErr.StatusCode
+		result.GraphQLErrors = httpErr.Response.Errors
+		result.Kind = classifyStatus(httpErr.StatusCode)
+		if result.Kind == ErrorKindUnknown {
+			result.Kind = classifyGraphQLErrors(httpErr.Response.Errors)
+		}
+
+	case errors.As(err, &gqlErrs):
+		result.GraphQLErrors = gqlErrs
+		result.Kind = classifyGraphQLErrors(gqlErrs)
+
+	case errors.Is(err, context.DeadlineExceeded):
+		result.Kind = ErrorKindTransient
+
+	case errors.As(err, &netErr):
+		result.Kind = ErrorKindTransient
+	}
+
+	return result
+}
+
+func classifyStatus(statusCode int) ErrorKind {
+	if _, ok := transientStatuses[statusCode]; ok {
+		return ErrorKindTransient
+	}
+
+	switch statusCode {
+	case 401, 403:
+		return ErrorKindAuth
+	case 404:
+		return ErrorKindNotFound
+	case 400, 422:
+		return ErrorKindValidation
+	}
+	return ErrorKindUnknown
+}
+
+// classifyGraphQLErrors returns the kind of the first error with a known
+// code. The messages aren't used, as they aren't stable.
+func classifyGraphQLErrors(errs gqlerror.List) ErrorKind {
+	for _, e := range errs {
+		if code, ok := e.Extensions["code"].(string); ok {
+			if kind, ok := graphQLErrorKinds[code]; ok {
+				return kind
+			}
+		}
+	}
+	return ErrorKindUnknown
+}
@@ -0,0 +1,132 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+type GetOrganizationInput struct {
+	// Organization is the slug of the organization, defaults to the
+	// organization of the client.
+	Organization string
+}
+
+type UpdateOrganizationInput struct {
+	// Organization is the current slug of the organization, defaults to the
+	// organization of the client.
+	Organization string
+	// Slug is the new slug, the slug is kept when empty.
+	Slug string
+	// MonthlyOperationsLimit is the new monthly operations limit, the limit
+	// is kept when nil.
+	MonthlyOperationsLimit *int64
+}
+
+type Organization struct {
+	Id   string
+	Slug string
+	Plan string
+	// MonthlyOperationsLimit is the number of operations that are collected
+	// per month.
+	MonthlyOperationsLimit int64
+	RetentionInDays        int
+	// LimitedForOperations is true when the organization reached its
+	// monthly operations limit.
+	LimitedForOperations bool
+}
+
+/**
+ * GetOrganization() fetches the details of the organization.
+ */
+func (hc *HiveClient) GetOrganization(ctx context.Context, input *GetOrganizationInput) (*Organization, error) {
+	selector, err := hc.organizationSelector(ctx, input.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.GetOrganization(ctx, *hc.client, *selector)
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	if data.Organization == nil {
+		return nil, newError(ErrorKindNotFound, "organization %s not found", selector.OrganizationSlug)
+	}
+
+	result := newOrganization(&data.Organization.Organization.OrganizationDetails)
+	return &result, nil
+}
+
+/**
+ * UpdateOrganization() changes the slug and the monthly operations limit of
+ * the organization. The slug is changed first and the limit is changed on the
+ * renamed organization. When only changing the limit fails, the renamed
+ * organization is returned along with the error.
+ */
+func (hc *HiveClient) UpdateOrganization(ctx context.Context, input *UpdateOrganizationInput) (*Organization, error) {
+	selector, err := hc.organizationSelector(ctx, input.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	var result *Organization
+
+	if input.Slug != "" && input.Slug != selector.OrganizationSlug {
+		data, err := client.UpdateOrganizationSlug(ctx, *hc.client, client.UpdateOrganizationSlugInput{
+			OrganizationSlug: selector.OrganizationSlug,
+			Slug:             input.Slug,
+		})
+		if err != nil {
+			return nil, wrapRequestError(err)
+		}
+
+		if data.UpdateOrganizationSlug.Error != nil {
+			return nil, newResultError("failed to change the organization slug: %s", data.UpdateOrganizationSlug.Error.Message)
+		}
+		if data.UpdateOrganizationSlug.Ok == nil {
+			return nil, newResultError("failed to change the organization slug")
+		}
+
+		organization := newOrganization(&data.UpdateOrganizationSlug.Ok.UpdatedOrganizationPayload.Organization.OrganizationDetails)
+		result = &organization
+		selector = &client.OrganizationSelectorInput{OrganizationSlug: organization.Slug}
+	}
+
+	if input.MonthlyOperationsLimit != nil {
+		data, err := client.UpdateOrgRateLimit(ctx, *hc.client, *selector, client.RateLimitInput{
+			Operations: *input.MonthlyOperationsLimit,
+		})
+		if err != nil {
+			return result, wrapRequestError(err)
+		}
+
+		organization := newOrganization(&data.UpdateOrgRateLimit.OrganizationDetails)
+		result = &organization
+	}
+
+	if result == nil {
+		return hc.GetOrganization(ctx, &GetOrganizationInput{Organization: selector.OrganizationSlug})
+	}
+	return result, nil
+}
+
+// organizationSelector returns the selector for the organization with the
+// slug, or for the organization of the client when the slug is empty.
+func (hc *HiveClient) organizationSelector(ctx context.Context, organization string) (*client.OrganizationSelectorInput, error) {
+	if organization != "" {
+		return &client.OrganizationSelectorInput{OrganizationSlug: organization}, nil
+	}
+	return hc.resolveOrganization(ctx)
+}
+
+func newOrganization(details *client.OrganizationDetails) Organization {
+	return Organization{
+		Id:                     details.GetId(),
+		Slug:                   details.GetSlug(),
+		Plan:                   string(details.GetPlan()),
+		MonthlyOperationsLimit: details.RateLimit.GetOperations(),
+		RetentionInDays:        details.RateLimit.GetRetentionInDays(),
+		LimitedForOperations:   details.RateLimit.GetLimitedForOperations(),
+	}
+}
@@ -0,0 +1,72 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+type GetProjectInput struct {
+	Project string
+}
+
+type Project struct {
+	Id            string
+	Slug          string
+	Type          string
+	BuildUrl      *string
+	ValidationUrl *string
+}
+
+/**
+ * GetProject() fetches the details of the project.
+ */
+func (hc *HiveClient) GetProject(ctx context.Context, input *GetProjectInput) (*Project, error) {
+	selector, err := hc.resolveProject(ctx, input.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.GetProject(ctx, *hc.client, *selector)
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	if data.Project == nil {
+		return nil, newError(ErrorKindNotFound, "project %s/%s not found", selector.OrganizationSlug, selector.ProjectSlug)
+	}
+
+	result := newProject(&data.Project.ProjectDetails)
+	return &result, nil
+}
+
+/**
+ * ListProjects() fetches the details of all projects of the organization.
+ */
+func (hc *HiveClient) ListProjects(ctx context.Context) ([]Project, error) {
+	selector, err := hc.resolveOrganization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.ListProjects(ctx, *hc.client, *selector)
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	result := make([]Project, 0, len(data.Projects.Nodes))
+	for _, node := range data.Projects.Nodes {
+		result = append(result, newProject(&node.ProjectDetails))
+	}
+	return result, nil
+}
+
+func newProject(details *client.ProjectDetails) Project {
+	return Project{
+		Id:            details.GetId(),
+		Slug:          details.GetSlug(),
+		Type:          string(details.GetType()),
+		BuildUrl:      details.GetBuildUrl(),
+		ValidationUrl: details.GetValidationUrl(),
+	}
+}
@@ -0,0 +1,214 @@
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries     = 3
+	DefaultRequestTimeout = 60 * time.Second
+
+	retryBaseDelay = 500 * time.Millisecond
+	retryMaxDelay  = 30 * time.Second
+)
+
+// RetryOptions configures the RetryTransport.
+type RetryOptions struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// RequestTimeout limits the duration of a single attempt, zero disables it.
+	RequestTimeout time.Duration
+}
+
+// RetryTransport retries requests that failed with a transient error using an
+// exponential backoff with jitter. Mutations are only retried when Hive
+// didn't process the request, so they are never applied twice.
+type RetryTransport struct {
+	transport http.RoundTripper
+	options   RetryOptions
+}
+
+func NewRetryTransport(innerTransport http.RoundTripper, options RetryOptions) http.RoundTripper {
+	return &RetryTransport{
+		transport: innerTransport,
+		options:   options,
+	}
+}
+
+func (t *RetryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	body, err := readBody(request)
+	if err != nil {
+		return nil, err
+	}
+	mutation := isMutation(body)
+
+	for attempt := 0; ; attempt++ {
+		response, err := t.attempt(request, body)
+
+		retry, wait := t.shouldRetry(response, err, mutation)
+		if !retry || attempt >= t.options.MaxRetries {
+			return response, err
+		}
+
+		if wait == 0 {
+			wait = backoff(attempt)
+		}
+
+		if response != nil {
+			_, _ = io.Copy(io.Discard, response.Body)
+			response.Body.Close()
+		}
+
+		tflog.Debug(request.Context(), fmt.Sprintf("Retrying request in %s (attempt %d of %d)", wait, attempt+1, t.options.MaxRetries), map[string]any{
+			"request_id": request.Header.Get(RequestIdHeader),
+		})
+
+		select {
+		case <-request.Context().Done():
+			return nil, request.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// attempt sends a single request, limited to the request timeout.
+func (t *RetryTransport) attempt(request *http.Request, body []byte) (*http.Response, error) {
+	ctx := request.Context()
+	cancel := context.CancelFunc(func() {})
+	if t.options.RequestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.options.RequestTimeout)
+	}
+
+	req := request.Clone(ctx)
+	if body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	response, err := t.transport.RoundTrip(req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The timeout also applies to reading the body, so only cancel once the
+	// body is closed.
+	response.Body = &cancelBody{ReadCloser: response.Body, cancel: cancel}
+	return response, nil
+}
+
+// shouldRetry determines whether the request can safely be retried, and how
+// long to wait when the server asked for it.
+func (t *RetryTransport) shouldRetry(response *http.Response, err error, mutation bool) (bool, time.Duration) {
+	if err != nil {
+		// The request never reached Hive, so it is always safe to retry.
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true, 0
+		}
+
+		if errors.Is(err, context.Canceled) {
+			return false, 0
+		}
+
+		return !mutation, 0
+	}
+
+	// Some requests are rejected before they are processed, and those are
+	// always safe to retry.
+	if always, ok := transientStatuses[response.StatusCode]; ok {
+		return always || !mutation, retryAfter(response)
+	}
+
+	return false, 0
+}
+
+// backoff returns the exponential backoff for the given attempt with full
+// jitter.
+func backoff(attempt int) time.Duration {
+	delay := retryBaseDelay << attempt
+	if delay <= 0 || delay > retryMaxDelay {
+		delay = retryMaxDelay
+	}
+	return time.Duration(rand.Int64N(int64(delay))) + time.Millisecond
+}
+
+// retryAfter parses the Retry-After header, which is either a number of
+// seconds or a HTTP date.
+func retryAfter(response *http.Response) time.Duration {
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		wait = time.Until(date)
+	}
+
+	if wait < 0 {
+		return 0
+	}
+	if wait > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return wait
+}
+
+func readBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	request.Body.Close()
+	return body, nil
+}
+
+// isMutation reports whether the body contains a GraphQL mutation. Unknown
+// bodies are treated as mutations, since those can't safely be retried.
+func isMutation(body []byte) bool {
+	if body == nil {
+		return false
+	}
+
+	payload := struct {
+		Query string `json:"query"`
+	}{}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return true
+	}
+
+	query := strings.TrimSpace(payload.Query)
+	return query == "" || strings.HasPrefix(query, "mutation")
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
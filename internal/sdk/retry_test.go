@@ -0,0 +1,173 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const (
+	testQuery    = `{"query":"query Target { target { id } }"}`
+	testMutation = `{"query":"mutation Publish { schemaPublish { __typename } }"}`
+)
+
+func TestIsMutation(t *testing.T) {
+	tests := []struct {
+		name string
+		body []byte
+		want bool
+	}{
+		{name: "no body", body: nil, want: false},
+		{name: "query", body: []byte(testQuery), want: false},
+		{name: "anonymous query", body: []byte(`{"query":"{ target { id } }"}`), want: false},
+		{name: "mutation", body: []byte(testMutation), want: true},
+		{name: "mutation with whitespace", body: []byte(`{"query":"\n  mutation { a }"}`), want: true},
+		{name: "empty query", body: []byte(`{"query":""}`), want: true},
+		{name: "invalid JSON", body: []byte(`query { a }`), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isMutation(tt.body); got != tt.want {
+				t.Errorf("isMutation(%q) = %v, want %v", tt.body, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		statuses []int
+		want     int
+		attempts int32
+	}{
+		{name: "success", body: testQuery, statuses: []int{200}, want: 200, attempts: 1},
+		{name: "query retried after 502", body: testQuery, statuses: []int{502, 200}, want: 200, attempts: 2},
+		{name: "query retried after 504", body: testQuery, statuses: []int{504, 200}, want: 200, attempts: 2},
+		{name: "mutation not retried after 502", body: testMutation, statuses: []int{502, 200}, want: 502, attempts: 1},
+		{name: "mutation not retried after 504", body: testMutation, statuses: []int{504, 200}, want: 504, attempts: 1},
+		{name: "mutation retried after 429", body: testMutation, statuses: []int{429, 200}, want: 200, attempts: 2},
+		{name: "mutation retried after 503", body: testMutation, statuses: []int{503, 200}, want: 200, attempts: 2},
+		{name: "client errors not retried", body: testQuery, statuses: []int{400, 200}, want: 400, attempts: 1},
+		{name: "server errors not retried", body: testQuery, statuses: []int{500, 200}, want: 500, attempts: 1},
+		{name: "retries exhausted", body: testQuery, statuses: []int{503, 503, 200}, want: 503, attempts: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := attempts.Add(1)
+
+				// Every attempt must send the full body.
+				body, _ := io.ReadAll(r.Body)
+				if string(body) != tt.body {
+					t.Errorf("attempt %d sent body %q, want %q", attempt, body, tt.body)
+				}
+
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(tt.statuses[attempt-1])
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, RetryOptions{MaxRetries: 1})}
+			response, err := client.Post(server.URL, "application/json", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			response.Body.Close()
+
+			if response.StatusCode != tt.want {
+				t.Errorf("got status %d, want %d", response.StatusCode, tt.want)
+			}
+			if got := attempts.Load(); got != tt.attempts {
+				t.Errorf("got %d attempts, want %d", got, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestRetryTransportRequestTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		attempts int32
+		wantErr  bool
+	}{
+		{name: "query retried", body: testQuery, attempts: 2},
+		{name: "mutation not retried", body: testMutation, attempts: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// Only the first attempt is slower than the request timeout.
+				if attempts.Add(1) == 1 {
+					select {
+					case <-r.Context().Done():
+					case <-time.After(500 * time.Millisecond):
+					}
+				}
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, RetryOptions{
+				MaxRetries:     1,
+				RequestTimeout: 50 * time.Millisecond,
+			})}
+			response, err := client.Post(server.URL, "application/json", strings.NewReader(tt.body))
+			if tt.wantErr {
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("got error %v, want %v", err, context.DeadlineExceeded)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				response.Body.Close()
+			}
+
+			if got := attempts.Load(); got != tt.attempts {
+				t.Errorf("got %d attempts, want %d", got, tt.attempts)
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{name: "not set", value: "", want: 0},
+		{name: "seconds", value: "5", want: 5 * time.Second},
+		{name: "capped", value: "120", want: retryMaxDelay},
+		{name: "negative", value: "-3", want: 0},
+		{name: "date in the past", value: "Mon, 02 Jan 2006 15:04:05 GMT", want: 0},
+		{name: "date capped", value: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), want: retryMaxDelay},
+		{name: "invalid", value: "soon", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := &http.Response{Header: http.Header{}}
+			if tt.value != "" {
+				response.Header.Set("Retry-After", tt.value)
+			}
+
+			if got := retryAfter(response); got != tt.want {
+				t.Errorf("retryAfter(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -26,6 +26,10 @@ type SchemaCheckResult struct {
 }
 
 func (hc *HiveClient) SchemaCheck(ctx context.Context, input *SchemaCheckInput) (*SchemaCheckResult, error) {
+	target, err := hc.getTarget(input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
 
 	meta := &client.SchemaCheckMetaInput{
 		Author: input.Author,
@@ -45,19 +49,26 @@ func (hc *HiveClient) SchemaCheck(ctx context.Context, input *SchemaCheckInput)
 		}
 	}
 
+	// The schema is sent in the same form as the normalize_schema function
+	// returns, so both can be compared.
+	sdl, err := NormalizeSchema(input.Schema)
+	if err != nil {
+		return nil, newError(ErrorKindValidation, "invalid schema: %s", err)
+	}
+
 	vars := client.SchemaCheckInput{
 		Service:   input.Service,
-		Sdl:       minifySchema(input.Schema),
+		Sdl:       sdl,
 		Meta:      meta,
 		ContextId: input.ContextId,
-		Target:    getTarget(ctx, hc.Organization, input.Project, input.Target),
+		Target:    target,
 	}
 
 	data, err := client.SchemaCheck(ctx, *hc.client, vars)
 
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("error: %v", err))
-		return nil, err
+		return nil, wrapRequestError(err)
 	}
 
 	switch v := data.SchemaCheck.(type) {
@@ -0,0 +1,137 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+// schemaChecksPageSize is the maximum number of schema checks requested per
+// page.
+const schemaChecksPageSize = 50
+
+type ListSchemaChecksInput struct {
+	Project string
+	Target  string
+	// Failed only returns the failed schema checks when true, and only the
+	// successful ones when false.
+	Failed *bool
+	// Changed only returns the schema checks with schema changes when true,
+	// and only the ones without when false.
+	Changed *bool
+	// After is the cursor to continue listing from.
+	After string
+	// Limit is the maximum number of schema checks to return.
+	Limit int
+}
+
+type SchemaCheckSummary struct {
+	Id                           string
+	CreatedAt                    string
+	Service                      *string
+	Author                       *string
+	Commit                       *string
+	ContextId                    *string
+	URL                          *string
+	Valid                        bool
+	HasSchemaChanges             bool
+	HasUnapprovedBreakingChanges bool
+	HasSchemaCompositionErrors   bool
+	Approved                     bool
+	ApprovedBy                   *string
+	ApprovalComment              *string
+}
+
+type ListSchemaChecksResult struct {
+	TargetId     string
+	SchemaChecks []SchemaCheckSummary
+	// EndCursor is the cursor to pass as After to continue listing, and is
+	// empty when there are no more schema checks.
+	EndCursor string
+}
+
+/**
+ * ListSchemaChecks() pages through the schema checks of the target, newest
+ * first, until the limit is reached.
+ */
+func (hc *HiveClient) ListSchemaChecks(ctx context.Context, input *ListSchemaChecksInput) (*ListSchemaChecksResult, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters *client.SchemaChecksFilter
+	if input.Failed != nil || input.Changed != nil {
+		filters = &client.SchemaChecksFilter{
+			Failed:  input.Failed,
+			Changed: input.Changed,
+		}
+	}
+
+	result := ListSchemaChecksResult{}
+
+	var after *string
+	if input.After != "" {
+		after = &input.After
+	}
+
+	for len(result.SchemaChecks) < input.Limit {
+		first := min(input.Limit-len(result.SchemaChecks), schemaChecksPageSize)
+
+		data, err := client.SchemaChecks(ctx, *hc.client, *selector, filters, after, first)
+		if err != nil {
+			return nil, wrapRequestError(err)
+		}
+
+		if data.Target == nil {
+			return nil, newError(ErrorKindNotFound, "target %s/%s/%s not found", selector.OrganizationSlug, selector.ProjectSlug, selector.TargetSlug)
+		}
+
+		result.TargetId = data.Target.GetId()
+
+		connection := data.Target.SchemaChecks
+		for _, edge := range connection.Edges {
+			result.SchemaChecks = append(result.SchemaChecks, newSchemaCheckSummary(edge.GetNode()))
+		}
+
+		result.EndCursor = ""
+		if !connection.PageInfo.HasNextPage || len(connection.Edges) == 0 {
+			break
+		}
+
+		cursor := connection.PageInfo.EndCursor
+		result.EndCursor = cursor
+		after = &cursor
+	}
+
+	return &result, nil
+}
+
+func newSchemaCheckSummary(node client.SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSchemaCheck) SchemaCheckSummary {
+	result := SchemaCheckSummary{
+		Id:                           node.GetId(),
+		CreatedAt:                    node.GetCreatedAt(),
+		Service:                      node.GetServiceName(),
+		ContextId:                    node.GetContextId(),
+		URL:                          node.GetWebUrl(),
+		HasSchemaChanges:             node.GetHasSchemaChanges(),
+		HasUnapprovedBreakingChanges: node.GetHasUnapprovedBreakingChanges(),
+		HasSchemaCompositionErrors:   node.GetHasSchemaCompositionErrors(),
+	}
+
+	if meta := node.GetMeta(); meta != nil {
+		result.Author = &meta.Author
+		result.Commit = &meta.Commit
+	}
+
+	if v, ok := node.(*client.SchemaChecksTargetSchemaChecksSchemaCheckConnectionEdgesSchemaCheckEdgeNodeSuccessfulSchemaCheck); ok {
+		result.Valid = true
+		result.Approved = v.GetIsApproved()
+		result.ApprovalComment = v.GetApprovalComment()
+		if approvedBy := v.GetApprovedBy(); approvedBy != nil {
+			result.ApprovedBy = &approvedBy.DisplayName
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,866 @@
+package sdk
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+// Subgraph is a subgraph to compose into a supergraph.
+type Subgraph struct {
+	Name string
+	URL  string
+	SDL  string
+}
+
+// CompositionError describes why the subgraphs don't compose. The codes follow
+// the error codes of the Federation composition that Hive runs, and
+// UNSUPPORTED_FEATURE is returned for the parts of Federation that
+// ComposeSupergraph doesn't support.
+type CompositionError struct {
+	Code    string
+	Message string
+}
+
+func (e CompositionError) Error() string {
+	return e.Message
+}
+
+// federationTypes are the types that subgraphs define for the Federation spec,
+// which aren't part of the supergraph.
+var federationTypes = map[string]bool{
+	"_Any":                          true,
+	"_Entity":                       true,
+	"_Service":                      true,
+	"FieldSet":                      true,
+	"federation__ContextFieldValue": true,
+	"federation__FieldSet":          true,
+	"federation__Policy":            true,
+	"federation__Scope":             true,
+	"link__Import":                  true,
+	"link__Purpose":                 true,
+}
+
+// unsupportedDirectives are the Federation directives that change the
+// supergraph in ways ComposeSupergraph doesn't support. Subgraphs using them
+// are rejected, rather than composed into a supergraph that differs from the
+// one of Hive.
+var unsupportedDirectives = []string{
+	"authenticated",
+	"composeDirective",
+	"context",
+	"cost",
+	"fromContext",
+	"inaccessible",
+	"interfaceObject",
+	"listSize",
+	"override",
+	"policy",
+	"requiresScopes",
+	"tag",
+}
+
+// supergraphHeader contains the schema definition and the definitions of the
+// link and join specs, which every supergraph starts with.
+const supergraphHeader = `schema
+  @link(url: "https://specs.apollo.dev/link/v1.0")
+  @link(url: "https://specs.apollo.dev/join/v0.3", for: EXECUTION)
+{
+%s}
+
+directive @join__enumValue(graph: join__Graph!) repeatable on ENUM_VALUE
+
+directive @join__field(graph: join__Graph, requires: join__FieldSet, provides: join__FieldSet, type: String, external: Boolean, override: String, usedOverridden: Boolean) repeatable on FIELD_DEFINITION | INPUT_FIELD_DEFINITION
+
+directive @join__graph(name: String!, url: String!) on ENUM_VALUE
+
+directive @join__implements(graph: join__Graph!, interface: String!) repeatable on OBJECT | INTERFACE
+
+directive @join__type(graph: join__Graph!, key: join__FieldSet, extension: Boolean! = false, resolvable: Boolean! = true, isInterfaceObject: Boolean! = false) repeatable on OBJECT | INTERFACE | UNION | ENUM | INPUT_OBJECT | SCALAR
+
+directive @join__unionMember(graph: join__Graph!, member: String!) repeatable on UNION
+
+directive @link(url: String, as: String, for: link__Purpose, import: [link__Import]) repeatable on SCHEMA
+
+scalar join__FieldSet
+
+scalar link__Import
+
+enum link__Purpose {
+  SECURITY
+  EXECUTION
+}
+`
+
+// ComposeSupergraph composes the subgraphs into a supergraph without
+// contacting Hive. It is experimental and only supports a subset of Federation v2: entities,
+// shareable and external fields, @requires and @provides, and the merging
+// rules for the types of fields, arguments, input objects, enums and unions.
+// Subgraphs using one of the unsupportedDirectives fail with
+// UNSUPPORTED_FEATURE, and custom directives other than @deprecated and
+// @specifiedBy aren't carried over to the supergraph. Hive's own composition
+// remains the authority when publishing.
+//
+// It returns the supergraph SDL, or the errors when the subgraphs don't
+// compose.
+func ComposeSupergraph(subgraphs []Subgraph) (string, []CompositionError) {
+	c := &composition{}
+
+	if len(subgraphs) == 0 {
+		c.fail("NO_SUBGRAPHS", "No subgraphs to compose")
+		return "", c.errors
+	}
+
+	subgraphs = slices.Clone(subgraphs)
+	sort.Slice(subgraphs, func(i, j int) bool {
+		return subgraphs[i].Name < subgraphs[j].Name
+	})
+
+	graphs := map[string]bool{}
+	for _, subgraph := range subgraphs {
+		schema, err := indexSchema(subgraph.SDL)
+		if err != nil {
+			c.fail("INVALID_GRAPHQL", "[%s] %s", subgraph.Name, err)
+			continue
+		}
+		normalizeRootTypes(schema)
+		c.checkSupported(subgraph.Name, schema)
+
+		graph := graphName(subgraph.Name)
+		for graphs[graph] {
+			graph += "_"
+		}
+		graphs[graph] = true
+
+		c.subgraphs = append(c.subgraphs, &composedSubgraph{
+			Subgraph: subgraph,
+			graph:    graph,
+			schema:   schema,
+			fed2:     usesFederation2(schema),
+		})
+	}
+	if len(c.errors) > 0 {
+		return "", c.errors
+	}
+
+	definitions := c.mergeTypes()
+	if len(c.errors) > 0 {
+		return "", c.errors
+	}
+
+	if !slices.ContainsFunc(definitions, func(def *ast.Definition) bool { return def.Name == "Query" }) {
+		c.fail("NO_QUERIES", "No queries found in any subgraph: a supergraph must have a query root type.")
+		return "", c.errors
+	}
+
+	supergraph := c.print(definitions)
+	if _, err := validator.LoadSchema(validator.Prelude, &ast.Source{Name: "supergraph.graphql", Input: supergraph}); err != nil {
+		c.fail("INVALID_GRAPHQL", "%s", err)
+		return "", c.errors
+	}
+
+	return supergraph, nil
+}
+
+type composition struct {
+	subgraphs []*composedSubgraph
+	errors    []CompositionError
+}
+
+type composedSubgraph struct {
+	Subgraph
+	// graph is the value of the join__Graph enum for the subgraph.
+	graph  string
+	schema *schemaIndex
+	// fed2 is set when the subgraph links the Federation v2 spec. Fields of
+	// Federation v1 subgraphs are all shareable.
+	fed2 bool
+}
+
+// subgraphDefinition is the definition of a type in one of the subgraphs.
+type subgraphDefinition struct {
+	subgraph *composedSubgraph
+	def      *ast.Definition
+}
+
+func (c *composition) fail(code string, format string, args ...any) {
+	c.errors = append(c.errors, CompositionError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// checkSupported fails for every use of one of the unsupportedDirectives in
+// the subgraph.
+func (c *composition) checkSupported(subgraph string, schema *schemaIndex) {
+	check := func(directives ast.DirectiveList, subject string) {
+		for _, directive := range directives {
+			name := strings.TrimPrefix(directive.Name, "federation__")
+			if slices.Contains(unsupportedDirectives, name) {
+				c.fail("UNSUPPORTED_FEATURE",
+					"[%s] The @%s directive on %s isn't supported by the offline composition, use the composition of Hive instead",
+					subgraph, name, subject)
+			}
+		}
+	}
+
+	check(schema.schemaDirectives, "the schema")
+	for _, name := range sortedKeys(schema.types, nil) {
+		def := schema.types[name]
+		check(def.Directives, fmt.Sprintf("\"%s\"", name))
+		for _, field := range def.Fields {
+			check(field.Directives, fmt.Sprintf("\"%s.%s\"", name, field.Name))
+			for _, arg := range field.Arguments {
+				check(arg.Directives, fmt.Sprintf("\"%s.%s(%s:)\"", name, field.Name, arg.Name))
+			}
+		}
+		for _, value := range def.EnumValues {
+			check(value.Directives, fmt.Sprintf("\"%s.%s\"", name, value.Name))
+		}
+	}
+}
+
+// mergeTypes merges the definitions of the types in all subgraphs, sorted by
+// name.
+func (c *composition) mergeTypes() []*ast.Definition {
+	byName := map[string][]subgraphDefinition{}
+	for _, subgraph := range c.subgraphs {
+		for name, def := range subgraph.schema.types {
+			if def.BuiltIn || federationTypes[name] {
+				continue
+			}
+			byName[name] = append(byName[name], subgraphDefinition{subgraph, def})
+		}
+	}
+
+	names := make([]string, 0, len(byName))
+	for name := range byName {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	inputEnums, outputEnums := c.enumUsage()
+
+	result := make([]*ast.Definition, 0, len(names))
+	for _, name := range names {
+		defs := byName[name]
+
+		first, mismatch := defs[0], false
+		for _, other := range defs[1:] {
+			if other.def.Kind != first.def.Kind {
+				mismatch = true
+				c.fail("TYPE_KIND_MISMATCH",
+					"Type \"%s\" has mismatched kind: it is defined as %s in subgraph \"%s\" but %s in subgraph \"%s\"",
+					name, first.def.Kind, first.subgraph.Name, other.def.Kind, other.subgraph.Name)
+			}
+		}
+		if mismatch {
+			continue
+		}
+
+		merged := &ast.Definition{
+			Kind: first.def.Kind,
+			Name: name,
+		}
+		for _, d := range defs {
+			if merged.Description == "" {
+				merged.Description = d.def.Description
+			}
+			merged.Directives = append(merged.Directives, joinTypeDirectives(d)...)
+		}
+
+		switch merged.Kind {
+		case ast.Object, ast.Interface:
+			c.mergeFields(merged, defs)
+		case ast.InputObject:
+			c.mergeInputFields(merged, defs)
+		case ast.Enum:
+			c.mergeEnumValues(merged, defs, inputEnums[name], outputEnums[name])
+		case ast.Union:
+			mergeUnionMembers(merged, defs)
+		}
+
+		result = append(result, merged)
+	}
+
+	return result
+}
+
+// mergeFields merges the fields and interfaces of an object or interface
+// type.
+func (c *composition) mergeFields(merged *ast.Definition, defs []subgraphDefinition) {
+	for _, d := range defs {
+		for _, name := range d.def.Interfaces {
+			if !slices.Contains(merged.Interfaces, name) {
+				merged.Interfaces = append(merged.Interfaces, name)
+			}
+			merged.Directives = append(merged.Directives, newDirective("join__implements",
+				enumArgument("graph", d.subgraph.graph), stringArgument("interface", name)))
+		}
+	}
+
+	var names []string
+	byName := map[string][]*ast.FieldDefinition{}
+	owners := map[string][]subgraphDefinition{}
+	for _, d := range defs {
+		for _, field := range d.def.Fields {
+			if merged.Name == "Query" && (field.Name == "_service" || field.Name == "_entities") {
+				continue
+			}
+			if _, ok := byName[field.Name]; !ok {
+				names = append(names, field.Name)
+			}
+			byName[field.Name] = append(byName[field.Name], field)
+			owners[field.Name] = append(owners[field.Name], d)
+		}
+	}
+
+	for _, name := range names {
+		fields, fieldOwners := byName[name], owners[name]
+		coordinate := merged.Name + "." + name
+
+		var resolving, nonShareable []string
+		for i, field := range fields {
+			if isExternal(fieldOwners[i], field) {
+				continue
+			}
+			resolving = append(resolving, fieldOwners[i].subgraph.Name)
+			if !isShareable(fieldOwners[i], field) {
+				nonShareable = append(nonShareable, fieldOwners[i].subgraph.Name)
+			}
+		}
+		if len(resolving) > 1 && len(nonShareable) > 0 {
+			c.fail("INVALID_FIELD_SHARING",
+				"Non-shareable field \"%s\" is resolved from multiple subgraphs: it is resolved from %s and defined as non-shareable in %s",
+				coordinate, quoteList(resolving), quoteList(nonShareable))
+			continue
+		}
+
+		fieldType := fields[0].Type
+		for i, field := range fields[1:] {
+			switch {
+			case field.Type.String() == fieldType.String(), safeOutputChange(fieldType, field.Type):
+			case safeOutputChange(field.Type, fieldType):
+				fieldType = field.Type
+			default:
+				c.fail("FIELD_TYPE_MISMATCH",
+					"Type of field \"%s\" is incompatible across subgraphs: it has type \"%s\" in subgraph \"%s\" but type \"%s\" in subgraph \"%s\"",
+					coordinate, fields[0].Type, fieldOwners[0].subgraph.Name, field.Type, fieldOwners[i+1].subgraph.Name)
+			}
+		}
+
+		result := &ast.FieldDefinition{
+			Name:      name,
+			Type:      fieldType,
+			Arguments: c.mergeArguments(coordinate, fields, fieldOwners),
+		}
+		for _, field := range fields {
+			if result.Description == "" {
+				result.Description = field.Description
+			}
+			if result.Directives.ForName("deprecated") == nil {
+				result.Directives = append(result.Directives, keptDirectives(field.Directives)...)
+			}
+		}
+
+		// The join__field directives tell the router which subgraphs resolve
+		// the field, which is only needed when not all of them do.
+		needsJoin := len(fields) != len(defs)
+		for i, field := range fields {
+			if field.Type.String() != fieldType.String() || isExternal(fieldOwners[i], field) || hasAnyDirective(field.Directives, "requires", "provides") {
+				needsJoin = true
+			}
+		}
+		if needsJoin {
+			for i, field := range fields {
+				result.Directives = append(result.Directives, joinFieldDirective(fieldOwners[i], field, fieldType))
+			}
+		}
+
+		merged.Fields = append(merged.Fields, result)
+	}
+}
+
+// mergeArguments merges the arguments of a field. Only arguments defined in
+// all subgraphs are kept, using the strictest type.
+func (c *composition) mergeArguments(coordinate string, fields []*ast.FieldDefinition, owners []subgraphDefinition) ast.ArgumentDefinitionList {
+	var result ast.ArgumentDefinitionList
+	seen := map[string]bool{}
+
+	for i, field := range fields {
+		for _, arg := range field.Arguments {
+			if seen[arg.Name] {
+				continue
+			}
+			seen[arg.Name] = true
+
+			merged := &ast.ArgumentDefinition{
+				Name:         arg.Name,
+				Description:  arg.Description,
+				Type:         arg.Type,
+				DefaultValue: arg.DefaultValue,
+				Directives:   keptDirectives(arg.Directives),
+			}
+			inAll := true
+
+			for j, other := range fields {
+				otherArg := other.Arguments.ForName(arg.Name)
+				if otherArg == nil {
+					inAll = false
+					if isRequired(arg.Type, arg.DefaultValue) {
+						c.fail("REQUIRED_ARGUMENT_MISSING_IN_SOME_SUBGRAPH",
+							"Argument \"%s(%s:)\" is required in some subgraphs but does not appear in all subgraphs: it is required in subgraph \"%s\" but does not appear in subgraph \"%s\"",
+							coordinate, arg.Name, owners[i].subgraph.Name, owners[j].subgraph.Name)
+					}
+					continue
+				}
+
+				mergedType, ok := mergeInputType(merged.Type, otherArg.Type)
+				if !ok {
+					c.fail("FIELD_ARGUMENT_TYPE_MISMATCH",
+						"Type of argument \"%s(%s:)\" is incompatible across subgraphs: it has type \"%s\" in subgraph \"%s\" but type \"%s\" in subgraph \"%s\"",
+						coordinate, arg.Name, arg.Type, owners[i].subgraph.Name, otherArg.Type, owners[j].subgraph.Name)
+					continue
+				}
+				merged.Type = mergedType
+			}
+
+			if inAll {
+				result = append(result, merged)
+			}
+		}
+	}
+
+	return result
+}
+
+// mergeInputFields merges the fields of an input object type. Only fields
+// defined in all subgraphs are kept, using the strictest type.
+func (c *composition) mergeInputFields(merged *ast.Definition, defs []subgraphDefinition) {
+	seen := map[string]bool{}
+
+	for i, d := range defs {
+		for _, field := range d.def.Fields {
+			if seen[field.Name] {
+				continue
+			}
+			seen[field.Name] = true
+
+			result := &ast.FieldDefinition{
+				Name:         field.Name,
+				Description:  field.Description,
+				Type:         field.Type,
+				DefaultValue: field.DefaultValue,
+				Directives:   keptDirectives(field.Directives),
+			}
+			inAll := true
+
+			for j, other := range defs {
+				otherField := other.def.Fields.ForName(field.Name)
+				if otherField == nil {
+					inAll = false
+					if isRequired(field.Type, field.DefaultValue) {
+						c.fail("REQUIRED_INPUT_FIELD_MISSING_IN_SOME_SUBGRAPH",
+							"Input object field \"%s.%s\" is required in some subgraphs but does not appear in all subgraphs: it is required in subgraph \"%s\" but does not appear in subgraph \"%s\"",
+							merged.Name, field.Name, defs[i].subgraph.Name, other.subgraph.Name)
+					}
+					continue
+				}
+
+				mergedType, ok := mergeInputType(result.Type, otherField.Type)
+				if !ok {
+					c.fail("FIELD_TYPE_MISMATCH",
+						"Type of field \"%s.%s\" is incompatible across subgraphs: it has type \"%s\" in subgraph \"%s\" but type \"%s\" in subgraph \"%s\"",
+						merged.Name, field.Name, field.Type, defs[i].subgraph.Name, otherField.Type, defs[j].subgraph.Name)
+					continue
+				}
+				result.Type = mergedType
+			}
+
+			if inAll {
+				merged.Fields = append(merged.Fields, result)
+			}
+		}
+	}
+
+	if len(merged.Fields) == 0 {
+		c.fail("EMPTY_MERGED_INPUT_TYPE",
+			"None of the fields of input object type \"%s\" are consistently defined in all the subgraphs defining that type. "+
+				"As only fields common to all subgraphs are merged, this would result in an empty type.", merged.Name)
+	}
+}
+
+// mergeEnumValues merges the values of an enum. Enums only used as output
+// types get the values of all subgraphs, enums only used as input types the
+// values defined in all subgraphs, and enums used as both must define the
+// same values in all subgraphs.
+func (c *composition) mergeEnumValues(merged *ast.Definition, defs []subgraphDefinition, input bool, output bool) {
+	for _, d := range defs {
+		for _, value := range d.def.EnumValues {
+			var missing []string
+			for _, other := range defs {
+				if other.def.EnumValues.ForName(value.Name) == nil {
+					missing = append(missing, other.subgraph.Name)
+				}
+			}
+
+			if len(missing) > 0 && input && output {
+				c.fail("ENUM_VALUE_MISMATCH",
+					"Enum type \"%s\" is used as both input type and output type, but value \"%s\" is not defined in all the subgraphs defining \"%s\": it is defined in subgraph \"%s\" but not in %s",
+					merged.Name, value.Name, merged.Name, d.subgraph.Name, quoteList(missing))
+				continue
+			}
+			if len(missing) > 0 && input {
+				continue
+			}
+
+			result := merged.EnumValues.ForName(value.Name)
+			if result == nil {
+				result = &ast.EnumValueDefinition{
+					Name:        value.Name,
+					Description: value.Description,
+					Directives:  keptDirectives(value.Directives),
+				}
+				merged.EnumValues = append(merged.EnumValues, result)
+			}
+			result.Directives = append(result.Directives, newDirective("join__enumValue",
+				enumArgument("graph", d.subgraph.graph)))
+		}
+	}
+}
+
+// mergeUnionMembers merges the members of a union.
+func mergeUnionMembers(merged *ast.Definition, defs []subgraphDefinition) {
+	for _, d := range defs {
+		for _, member := range d.def.Types {
+			if !slices.Contains(merged.Types, member) {
+				merged.Types = append(merged.Types, member)
+			}
+			merged.Directives = append(merged.Directives, newDirective("join__unionMember",
+				enumArgument("graph", d.subgraph.graph), stringArgument("member", member)))
+		}
+	}
+}
+
+// enumUsage returns which enums are used as input types, and which as output
+// types, in any of the subgraphs.
+func (c *composition) enumUsage() (map[string]bool, map[string]bool) {
+	input, output := map[string]bool{}, map[string]bool{}
+
+	for _, subgraph := range c.subgraphs {
+		for _, def := range subgraph.schema.types {
+			for _, field := range def.Fields {
+				if def.Kind == ast.InputObject {
+					input[field.Type.Name()] = true
+					continue
+				}
+				output[field.Type.Name()] = true
+				for _, arg := range field.Arguments {
+					input[arg.Type.Name()] = true
+				}
+			}
+		}
+	}
+
+	return input, output
+}
+
+// print returns the supergraph SDL for the merged definitions.
+func (c *composition) print(definitions []*ast.Definition) string {
+	var roots strings.Builder
+	for _, root := range []struct {
+		op   ast.Operation
+		name string
+	}{
+		{ast.Query, "Query"},
+		{ast.Mutation, "Mutation"},
+		{ast.Subscription, "Subscription"},
+	} {
+		if slices.ContainsFunc(definitions, func(def *ast.Definition) bool { return def.Name == root.name }) {
+			fmt.Fprintf(&roots, "  %s: %s\n", root.op, root.name)
+		}
+	}
+
+	graphs := &ast.Definition{Kind: ast.Enum, Name: "join__Graph"}
+	for _, subgraph := range c.subgraphs {
+		graphs.EnumValues = append(graphs.EnumValues, &ast.EnumValueDefinition{
+			Name: subgraph.graph,
+			Directives: ast.DirectiveList{newDirective("join__graph",
+				stringArgument("name", subgraph.Name), stringArgument("url", subgraph.URL))},
+		})
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, supergraphHeader, roots.String())
+	for _, def := range append([]*ast.Definition{graphs}, definitions...) {
+		buf.WriteString("\n")
+		formatter.NewFormatter(&buf, formatter.WithIndent("  ")).FormatSchemaDocument(&ast.SchemaDocument{Definitions: ast.DefinitionList{def}})
+	}
+	return buf.String()
+}
+
+// normalizeRootTypes renames the root types of the subgraph to Query,
+// Mutation and Subscription, which are the names used in the supergraph.
+func normalizeRootTypes(schema *schemaIndex) {
+	renames := map[string]string{}
+	for op, name := range map[ast.Operation]string{
+		ast.Query:        "Query",
+		ast.Mutation:     "Mutation",
+		ast.Subscription: "Subscription",
+	} {
+		if root, ok := schema.roots[op]; ok && root != name {
+			renames[root] = name
+			schema.roots[op] = name
+		}
+	}
+	if len(renames) == 0 {
+		return
+	}
+
+	var renameType func(t *ast.Type)
+	renameType = func(t *ast.Type) {
+		if t.Elem != nil {
+			renameType(t.Elem)
+		} else if name, ok := renames[t.NamedType]; ok {
+			t.NamedType = name
+		}
+	}
+
+	types := make(map[string]*ast.Definition, len(schema.types))
+	for name, def := range schema.types {
+		if renamed, ok := renames[name]; ok {
+			def.Name = renamed
+			name = renamed
+		}
+		for _, field := range def.Fields {
+			renameType(field.Type)
+			for _, arg := range field.Arguments {
+				renameType(arg.Type)
+			}
+		}
+		for i, member := range def.Types {
+			if renamed, ok := renames[member]; ok {
+				def.Types[i] = renamed
+			}
+		}
+		types[name] = def
+	}
+	schema.types = types
+}
+
+// usesFederation2 reports whether the subgraph links the Federation v2 spec.
+func usesFederation2(schema *schemaIndex) bool {
+	for _, directive := range schema.schemaDirectives.ForNames("link") {
+		if url := directive.Arguments.ForName("url"); url != nil && url.Value != nil &&
+			strings.Contains(url.Value.Raw, "specs.apollo.dev/federation/v2") {
+			return true
+		}
+	}
+	return false
+}
+
+// isShareable reports whether the field may be resolved by more than one
+// subgraph.
+func isShareable(d subgraphDefinition, field *ast.FieldDefinition) bool {
+	if !d.subgraph.fed2 {
+		return true
+	}
+	if field.Directives.ForName("shareable") != nil || d.def.Directives.ForName("shareable") != nil {
+		return true
+	}
+
+	for _, key := range d.def.Directives.ForNames("key") {
+		if fields := key.Arguments.ForName("fields"); fields != nil && fields.Value != nil &&
+			slices.Contains(fieldSetNames(fields.Value.Raw), field.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isExternal reports whether the field is external in the subgraph. Like the
+// Federation composition, @external is ignored on the key fields of type
+// extensions in Federation v1 subgraphs, as those were required there.
+func isExternal(d subgraphDefinition, field *ast.FieldDefinition) bool {
+	if field.Directives.ForName("external") == nil {
+		return false
+	}
+	if d.subgraph.fed2 {
+		return true
+	}
+
+	extends := d.def.Directives.ForName("extends") != nil
+	for _, key := range d.def.Directives.ForNames("key") {
+		if !extends && !d.subgraph.schema.extensionDirectives[key] {
+			continue
+		}
+		if fields := key.Arguments.ForName("fields"); fields != nil && fields.Value != nil &&
+			slices.Contains(fieldSetNames(fields.Value.Raw), field.Name) {
+			return false
+		}
+	}
+	return true
+}
+
+// fieldSetNames returns the names of the top level fields of a field set,
+// such as "id organization { id }".
+func fieldSetNames(fieldSet string) []string {
+	var names []string
+	depth := 0
+	for _, token := range strings.Fields(strings.NewReplacer("{", " { ", "}", " } ").Replace(fieldSet)) {
+		switch token {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		default:
+			if depth == 0 {
+				names = append(names, token)
+			}
+		}
+	}
+	return names
+}
+
+// joinTypeDirectives returns the join__type directives for the definition of
+// a type in a subgraph, one for each of its keys. Keys applied in a type
+// extension, or on a type with @extends, are marked as an extension.
+func joinTypeDirectives(d subgraphDefinition) ast.DirectiveList {
+	graph := enumArgument("graph", d.subgraph.graph)
+
+	keys := d.def.Directives.ForNames("key")
+	if len(keys) == 0 {
+		return ast.DirectiveList{newDirective("join__type", graph)}
+	}
+
+	var result ast.DirectiveList
+	for _, key := range keys {
+		args := []*ast.Argument{graph}
+		if fields := key.Arguments.ForName("fields"); fields != nil && fields.Value != nil {
+			args = append(args, stringArgument("key", fields.Value.Raw))
+		}
+		if d.subgraph.schema.extensionDirectives[key] || d.def.Directives.ForName("extends") != nil {
+			args = append(args, &ast.Argument{
+				Name:  "extension",
+				Value: &ast.Value{Kind: ast.BooleanValue, Raw: "true"},
+			})
+		}
+		if resolvable := key.Arguments.ForName("resolvable"); resolvable != nil && resolvable.Value != nil && resolvable.Value.Raw == "false" {
+			args = append(args, &ast.Argument{
+				Name:  "resolvable",
+				Value: &ast.Value{Kind: ast.BooleanValue, Raw: "false"},
+			})
+		}
+		result = append(result, newDirective("join__type", args...))
+	}
+	return result
+}
+
+// joinFieldDirective returns the join__field directive for the definition of
+// a field in a subgraph.
+func joinFieldDirective(d subgraphDefinition, field *ast.FieldDefinition, mergedType *ast.Type) *ast.Directive {
+	args := []*ast.Argument{enumArgument("graph", d.subgraph.graph)}
+
+	for _, name := range []string{"requires", "provides"} {
+		if directive := field.Directives.ForName(name); directive != nil {
+			if fields := directive.Arguments.ForName("fields"); fields != nil && fields.Value != nil {
+				args = append(args, stringArgument(name, fields.Value.Raw))
+			}
+		}
+	}
+	if field.Type.String() != mergedType.String() {
+		args = append(args, stringArgument("type", field.Type.String()))
+	}
+	if isExternal(d, field) {
+		args = append(args, &ast.Argument{
+			Name:  "external",
+			Value: &ast.Value{Kind: ast.BooleanValue, Raw: "true"},
+		})
+	}
+
+	return newDirective("join__field", args...)
+}
+
+// mergeInputType returns the strictest of two input types, which is the type
+// that is accepted by both subgraphs.
+func mergeInputType(a *ast.Type, b *ast.Type) (*ast.Type, bool) {
+	switch {
+	case a.String() == b.String(), safeInputChange(a, b):
+		return a, true
+	case safeInputChange(b, a):
+		return b, true
+	}
+	return nil, false
+}
+
+// keptDirectives returns the directives that are carried over to the
+// supergraph.
+func keptDirectives(directives ast.DirectiveList) ast.DirectiveList {
+	var result ast.DirectiveList
+	for _, directive := range directives {
+		if directive.Name == "deprecated" || directive.Name == "specifiedBy" {
+			result = append(result, directive)
+		}
+	}
+	return result
+}
+
+func hasAnyDirective(directives ast.DirectiveList, names ...string) bool {
+	for _, name := range names {
+		if directives.ForName(name) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func isRequired(t *ast.Type, defaultValue *ast.Value) bool {
+	return t.NonNull && defaultValue == nil
+}
+
+// graphName returns the join__Graph enum value for the subgraph name.
+func graphName(name string) string {
+	graph := strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		if r >= 'a' && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return '_'
+	}, name)
+
+	if graph == "" || (graph[0] >= '0' && graph[0] <= '9') {
+		graph = "_" + graph
+	}
+	return graph
+}
+
+func newDirective(name string, args ...*ast.Argument) *ast.Directive {
+	return &ast.Directive{Name: name, Arguments: args}
+}
+
+func enumArgument(name string, value string) *ast.Argument {
+	return &ast.Argument{Name: name, Value: &ast.Value{Kind: ast.EnumValue, Raw: value}}
+}
+
+func stringArgument(name string, value string) *ast.Argument {
+	return &ast.Argument{Name: name, Value: &ast.Value{Kind: ast.StringValue, Raw: value}}
+}
+
+func quoteList(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("subgraph \"%s\"", name)
+	}
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + " and " + quoted[len(quoted)-1]
+}
@@ -0,0 +1,307 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// fed2 links the Federation v2 spec, for use at the start of a subgraph.
+const fed2 = `extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key", "@shareable", "@external", "@requires", "@provides"])
+`
+
+func TestComposeSupergraph(t *testing.T) {
+	subgraphs := []Subgraph{
+		{
+			Name: "users",
+			URL:  "https://users.example.com/graphql",
+			SDL: fed2 + `
+				type Query { me: User }
+				type User @key(fields: "id") { id: ID! name: String! }`,
+		},
+		{
+			Name: "reviews",
+			URL:  "https://reviews.example.com/graphql",
+			SDL: fed2 + `
+				type Query { reviews: [Review!]! }
+				type Review { body: String! author: User! @provides(fields: "name") }
+				type User @key(fields: "id") { id: ID! name: String! @external reviews: [Review!]! nick: String @requires(fields: "name") }`,
+		},
+	}
+
+	want := fmt.Sprintf(supergraphHeader, "  query: Query\n") + `
+enum join__Graph {
+  REVIEWS @join__graph(name: "reviews", url: "https://reviews.example.com/graphql")
+  USERS @join__graph(name: "users", url: "https://users.example.com/graphql")
+}
+
+type Query @join__type(graph: REVIEWS) @join__type(graph: USERS) {
+  reviews: [Review!]! @join__field(graph: REVIEWS)
+  me: User @join__field(graph: USERS)
+}
+
+type Review @join__type(graph: REVIEWS) {
+  body: String!
+  author: User! @join__field(graph: REVIEWS, provides: "name")
+}
+
+type User @join__type(graph: REVIEWS, key: "id") @join__type(graph: USERS, key: "id") {
+  id: ID!
+  name: String! @join__field(graph: REVIEWS, external: true) @join__field(graph: USERS)
+  reviews: [Review!]! @join__field(graph: REVIEWS)
+  nick: String @join__field(graph: REVIEWS, requires: "name")
+}
+`
+
+	supergraph, errs := ComposeSupergraph(subgraphs)
+	if len(errs) > 0 {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+	if supergraph != want {
+		t.Errorf("unexpected supergraph\n got: %s\nwant: %s", supergraph, want)
+	}
+}
+
+func TestComposeSupergraphOutput(t *testing.T) {
+	tests := []struct {
+		name      string
+		subgraphs []Subgraph
+		want      []string
+	}{
+		{
+			name: "federation v1 type extensions",
+			subgraphs: []Subgraph{
+				{Name: "users", SDL: `
+					type Query { me: User }
+					type User @key(fields: "id") { id: ID! name: String }`},
+				{Name: "reviews", SDL: `
+					extend type Query { reviews: [Review] }
+					type Review { body: String author: User }
+					extend type User @key(fields: "id") { id: ID! @external reviews: [Review] }`},
+				{Name: "accounts", SDL: `
+					type Account @key(fields: "id") { id: ID! }
+					type User @extends @key(fields: "id") { id: ID! @external account: Account }`},
+			},
+			want: []string{
+				`type User @join__type(graph: ACCOUNTS, key: "id", extension: true) @join__type(graph: REVIEWS, key: "id", extension: true) @join__type(graph: USERS, key: "id") {`,
+				"  id: ID!\n",
+				`  name: String @join__field(graph: USERS)`,
+			},
+		},
+		{
+			name: "unresolvable keys",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: fed2 + `type Query { product: Product } type Product @key(fields: "id", resolvable: false) { id: ID! }`},
+				{Name: "b", SDL: fed2 + `type Product @key(fields: "id") { id: ID! name: String }`},
+			},
+			want: []string{
+				`type Product @join__type(graph: A, key: "id", resolvable: false) @join__type(graph: B, key: "id") {`,
+			},
+		},
+		{
+			name: "shareable fields merge to the nullable type",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: fed2 + `type Query { product: Product } type Product @shareable { name: String! }`},
+				{Name: "b", SDL: fed2 + `type Product { name: String @shareable }`},
+			},
+			want: []string{
+				`  name: String @join__field(graph: A, type: "String!") @join__field(graph: B)`,
+			},
+		},
+		{
+			name: "arguments merge to the strictest type",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { search(term: String!, limit: Int): [String] }`},
+				{Name: "b", SDL: `type Query { search(term: String): [String] }`},
+			},
+			want: []string{
+				`  search(term: String!): [String]`,
+			},
+		},
+		{
+			name: "input objects keep the fields of all subgraphs",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { a(filter: Filter): Int } input Filter { name: String! limit: Int }`},
+				{Name: "b", SDL: `type Query { b(filter: Filter): Int } input Filter { name: String }`},
+			},
+			want: []string{
+				"input Filter @join__type(graph: A) @join__type(graph: B) {\n  name: String!\n}",
+			},
+		},
+		{
+			name: "enums",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { status: Status a(order: Order): Int } enum Status { OPEN } enum Order { ASC DESC }`},
+				{Name: "b", SDL: `type Query { b(order: Order): Int } type Other { status: Status } enum Status { CLOSED } enum Order { ASC }`},
+			},
+			want: []string{
+				"enum Order @join__type(graph: A) @join__type(graph: B) {\n  ASC @join__enumValue(graph: A) @join__enumValue(graph: B)\n}",
+				"enum Status @join__type(graph: A) @join__type(graph: B) {\n  OPEN @join__enumValue(graph: A)\n  CLOSED @join__enumValue(graph: B)\n}",
+			},
+		},
+		{
+			name: "unions and interfaces",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { search: [Result] } union Result = Book interface Node { id: ID! } type Book implements Node { id: ID! }`},
+				{Name: "b", SDL: `union Result = Movie type Movie { id: ID! }`},
+			},
+			want: []string{
+				`union Result @join__type(graph: A) @join__type(graph: B) @join__unionMember(graph: A, member: "Book") @join__unionMember(graph: B, member: "Movie") = Book | Movie`,
+				`type Book implements Node @join__type(graph: A) @join__implements(graph: A, interface: "Node") {`,
+			},
+		},
+		{
+			name: "root types are renamed",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `schema { query: RootQuery } type RootQuery { a: Int }`},
+			},
+			want: []string{
+				"type Query @join__type(graph: A) {\n  a: Int\n}",
+			},
+		},
+		{
+			name: "deprecations are kept",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { a: Int @deprecated(reason: "use b") @custom b: Int }`},
+			},
+			want: []string{
+				`  a: Int @deprecated(reason: "use b")` + "\n",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			supergraph, errs := ComposeSupergraph(tt.subgraphs)
+			if len(errs) > 0 {
+				t.Fatalf("unexpected errors: %v", errs)
+			}
+
+			for _, want := range tt.want {
+				if !strings.Contains(supergraph, want) {
+					t.Errorf("supergraph doesn't contain %q\n%s", want, supergraph)
+				}
+			}
+		})
+	}
+}
+
+func TestComposeSupergraphErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		subgraphs []Subgraph
+		want      []string
+	}{
+		{
+			name: "no subgraphs",
+			want: []string{"NO_SUBGRAPHS"},
+		},
+		{
+			name: "invalid schema",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query {`},
+			},
+			want: []string{"INVALID_GRAPHQL"},
+		},
+		{
+			name: "no queries",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Mutation { a: Int }`},
+			},
+			want: []string{"NO_QUERIES"},
+		},
+		{
+			name: "type kind mismatch",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { a: Int } type Product { id: ID }`},
+				{Name: "b", SDL: `type Query { b: Int } input Product { id: ID }`},
+			},
+			want: []string{"TYPE_KIND_MISMATCH"},
+		},
+		{
+			name: "non-shareable field",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: fed2 + `type Query { a: Int } type Product { name: String }`},
+				{Name: "b", SDL: fed2 + `type Product { name: String }`},
+			},
+			want: []string{"INVALID_FIELD_SHARING"},
+		},
+		{
+			name: "field type mismatch",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { a: Int } type Product { name: String }`},
+				{Name: "b", SDL: `type Product { name: Int }`},
+			},
+			want: []string{"FIELD_TYPE_MISMATCH"},
+		},
+		{
+			name: "argument type mismatch",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { a(id: ID): Int }`},
+				{Name: "b", SDL: `type Query { a(id: Int): Int }`},
+			},
+			want: []string{"FIELD_ARGUMENT_TYPE_MISMATCH"},
+		},
+		{
+			name: "required argument missing",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { a(id: ID!): Int }`},
+				{Name: "b", SDL: `type Query { a: Int }`},
+			},
+			want: []string{"REQUIRED_ARGUMENT_MISSING_IN_SOME_SUBGRAPH"},
+		},
+		{
+			name: "required input field missing",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { a: Int } input Filter { name: String! limit: Int }`},
+				{Name: "b", SDL: `input Filter { limit: Int }`},
+			},
+			want: []string{"REQUIRED_INPUT_FIELD_MISSING_IN_SOME_SUBGRAPH"},
+		},
+		{
+			name: "empty input object",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { a: Int } input Filter { name: String }`},
+				{Name: "b", SDL: `input Filter { limit: Int }`},
+			},
+			want: []string{"EMPTY_MERGED_INPUT_TYPE"},
+		},
+		{
+			name: "enum value mismatch",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: `type Query { a(status: Status): Status } enum Status { OPEN }`},
+				{Name: "b", SDL: `enum Status { OPEN CLOSED }`},
+			},
+			want: []string{"ENUM_VALUE_MISMATCH"},
+		},
+		{
+			name: "unsupported directives",
+			subgraphs: []Subgraph{
+				{Name: "a", SDL: fed2 + `type Query { a: Int @override(from: "b") @federation__tag(name: "public") } enum E { A @inaccessible }`},
+				{Name: "b", SDL: fed2 + `type Query { a: Int }`},
+			},
+			want: []string{"UNSUPPORTED_FEATURE", "UNSUPPORTED_FEATURE", "UNSUPPORTED_FEATURE"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			supergraph, errs := ComposeSupergraph(tt.subgraphs)
+			if supergraph != "" {
+				t.Errorf("expected no supergraph, got:\n%s", supergraph)
+			}
+
+			codes := make([]string, 0, len(errs))
+			for _, e := range errs {
+				if e.Message == "" {
+					t.Errorf("error %s has no message", e.Code)
+				}
+				codes = append(codes, e.Code)
+			}
+			if !reflect.DeepEqual(codes, tt.want) {
+				t.Errorf("unexpected errors\n got: %v\nwant: %v", errs, tt.want)
+			}
+		})
+	}
+}
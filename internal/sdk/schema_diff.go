@@ -0,0 +1,586 @@
+package sdk
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// The criticality levels of a schema change, matching the CriticalityLevel
+// enum of Hive.
+const (
+	CriticalityBreaking  = "Breaking"
+	CriticalityDangerous = "Dangerous"
+	CriticalitySafe      = "Safe"
+)
+
+const defaultDeprecationReason = "No longer supported"
+
+// SchemaChange describes a single change between two schemas. The change types
+// and messages follow the ones Hive reports for schema checks.
+type SchemaChange struct {
+	Type        string
+	Criticality string
+	Path        []string
+	Message     string
+}
+
+// DiffSchemas compares two schemas without contacting Hive. The schemas are
+// only parsed, not validated, so subgraph schemas can be compared as well.
+func DiffSchemas(oldSDL string, newSDL string) ([]SchemaChange, error) {
+	oldSchema, err := indexSchema(oldSDL)
+	if err != nil {
+		return nil, fmt.Errorf("old schema: %w", err)
+	}
+
+	newSchema, err := indexSchema(newSDL)
+	if err != nil {
+		return nil, fmt.Errorf("new schema: %w", err)
+	}
+
+	d := &schemaDiff{changes: []SchemaChange{}}
+	d.compareRoots(oldSchema, newSchema)
+	d.compareTypes(oldSchema, newSchema)
+	d.compareDirectives(oldSchema, newSchema)
+	return d.changes, nil
+}
+
+type schemaIndex struct {
+	roots      map[ast.Operation]string
+	types      map[string]*ast.Definition
+	directives map[string]*ast.DirectiveDefinition
+	// schemaDirectives contains the directives applied to the schema itself,
+	// such as @link.
+	schemaDirectives ast.DirectiveList
+	// extensionDirectives contains the directives applied in type
+	// extensions, which are merged into the directives of the types.
+	extensionDirectives map[*ast.Directive]bool
+}
+
+// indexSchema parses the schema and merges the type extensions into their
+// definitions.
+func indexSchema(sdl string) (*schemaIndex, error) {
+	doc, err := parseSchema(sdl)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &schemaIndex{
+		roots:               map[ast.Operation]string{},
+		types:               map[string]*ast.Definition{},
+		directives:          map[string]*ast.DirectiveDefinition{},
+		extensionDirectives: map[*ast.Directive]bool{},
+	}
+
+	for _, def := range doc.Definitions {
+		schema.types[def.Name] = def
+	}
+
+	for _, ext := range doc.Extensions {
+		for _, directive := range ext.Directives {
+			schema.extensionDirectives[directive] = true
+		}
+
+		def, ok := schema.types[ext.Name]
+		if !ok {
+			schema.types[ext.Name] = ext
+			continue
+		}
+		def.Interfaces = append(def.Interfaces, ext.Interfaces...)
+		def.Fields = append(def.Fields, ext.Fields...)
+		def.Types = append(def.Types, ext.Types...)
+		def.EnumValues = append(def.EnumValues, ext.EnumValues...)
+		def.Directives = append(def.Directives, ext.Directives...)
+	}
+
+	for _, directive := range doc.Directives {
+		schema.directives[directive.Name] = directive
+	}
+
+	for _, def := range append(doc.Schema, doc.SchemaExtension...) {
+		schema.schemaDirectives = append(schema.schemaDirectives, def.Directives...)
+		for _, op := range def.OperationTypes {
+			schema.roots[op.Operation] = op.Type
+		}
+	}
+
+	// Without a schema definition the root types are found by their names.
+	if len(doc.Schema) == 0 {
+		defaults := map[ast.Operation]string{
+			ast.Query:        "Query",
+			ast.Mutation:     "Mutation",
+			ast.Subscription: "Subscription",
+		}
+		for op, name := range defaults {
+			if _, ok := schema.roots[op]; !ok && schema.types[name] != nil {
+				schema.roots[op] = name
+			}
+		}
+	}
+
+	return schema, nil
+}
+
+type schemaDiff struct {
+	changes []SchemaChange
+}
+
+func (d *schemaDiff) add(changeType string, criticality string, path []string, format string, args ...any) {
+	d.changes = append(d.changes, SchemaChange{
+		Type:        changeType,
+		Criticality: criticality,
+		Path:        path,
+		Message:     fmt.Sprintf(format, args...),
+	})
+}
+
+func (d *schemaDiff) compareRoots(oldSchema *schemaIndex, newSchema *schemaIndex) {
+	for _, op := range []ast.Operation{ast.Query, ast.Mutation, ast.Subscription} {
+		oldRoot, newRoot := oldSchema.roots[op], newSchema.roots[op]
+		if oldRoot == newRoot {
+			continue
+		}
+
+		criticality := CriticalityBreaking
+		if oldRoot == "" {
+			criticality = CriticalitySafe
+		}
+
+		d.add(
+			fmt.Sprintf("SCHEMA_%s_TYPE_CHANGED", strings.ToUpper(string(op))), criticality, nil,
+			"Schema %s root has changed from '%s' to '%s'", op, oldRoot, newRoot,
+		)
+	}
+}
+
+func (d *schemaDiff) compareTypes(oldSchema *schemaIndex, newSchema *schemaIndex) {
+	for _, name := range sortedKeys(oldSchema.types, newSchema.types) {
+		oldType, newType := oldSchema.types[name], newSchema.types[name]
+		path := []string{name}
+
+		switch {
+		case newType == nil:
+			d.add("TYPE_REMOVED", CriticalityBreaking, path, "Type '%s' was removed", name)
+			continue
+		case oldType == nil:
+			d.add("TYPE_ADDED", CriticalitySafe, path, "Type '%s' was added", name)
+			continue
+		case oldType.Kind != newType.Kind:
+			d.add("TYPE_KIND_CHANGED", CriticalityBreaking, path,
+				"'%s' kind changed from '%s' to '%s'", name, oldType.Kind, newType.Kind)
+			continue
+		}
+
+		d.compareDescription("TYPE", path, oldType.Description, newType.Description,
+			"type '"+name+"'")
+
+		switch oldType.Kind {
+		case ast.Object, ast.Interface:
+			d.compareInterfaces(oldType, newType)
+			d.compareFields(oldType, newType)
+		case ast.InputObject:
+			d.compareInputFields(oldType, newType)
+		case ast.Enum:
+			d.compareEnumValues(oldType, newType)
+		case ast.Union:
+			d.compareUnionMembers(oldType, newType)
+		}
+	}
+}
+
+func (d *schemaDiff) compareInterfaces(oldType *ast.Definition, newType *ast.Definition) {
+	path := []string{oldType.Name}
+
+	for _, name := range oldType.Interfaces {
+		if !slices.Contains(newType.Interfaces, name) {
+			d.add("OBJECT_TYPE_INTERFACE_REMOVED", CriticalityBreaking, path,
+				"'%s' object type no longer implements '%s' interface", oldType.Name, name)
+		}
+	}
+
+	for _, name := range newType.Interfaces {
+		if !slices.Contains(oldType.Interfaces, name) {
+			d.add("OBJECT_TYPE_INTERFACE_ADDED", CriticalityDangerous, path,
+				"'%s' object implements '%s' interface", oldType.Name, name)
+		}
+	}
+}
+
+func (d *schemaDiff) compareFields(oldType *ast.Definition, newType *ast.Definition) {
+	kind := kindName(oldType.Kind)
+
+	for _, oldField := range oldType.Fields {
+		path := []string{oldType.Name, oldField.Name}
+		coordinate := oldType.Name + "." + oldField.Name
+
+		newField := newType.Fields.ForName(oldField.Name)
+		if newField == nil {
+			d.add("FIELD_REMOVED", CriticalityBreaking, path,
+				"Field '%s' was removed from %s '%s'", oldField.Name, kind, oldType.Name)
+			continue
+		}
+
+		if oldField.Type.String() != newField.Type.String() {
+			criticality := CriticalityBreaking
+			if safeOutputChange(oldField.Type, newField.Type) {
+				criticality = CriticalitySafe
+			}
+			d.add("FIELD_TYPE_CHANGED", criticality, path,
+				"Field '%s' changed type from '%s' to '%s'", coordinate, oldField.Type, newField.Type)
+		}
+
+		d.compareDescription("FIELD", path, oldField.Description, newField.Description,
+			"field '"+coordinate+"'")
+		d.compareDeprecation("FIELD", path, oldField.Directives, newField.Directives,
+			"field '"+coordinate+"'")
+
+		for _, oldArg := range oldField.Arguments {
+			argPath := []string{oldType.Name, oldField.Name, oldArg.Name}
+
+			newArg := newField.Arguments.ForName(oldArg.Name)
+			if newArg == nil {
+				d.add("FIELD_ARGUMENT_REMOVED", CriticalityBreaking, argPath,
+					"Argument '%s: %s' was removed from field '%s'", oldArg.Name, oldArg.Type, coordinate)
+				continue
+			}
+
+			if oldArg.Type.String() != newArg.Type.String() {
+				criticality := CriticalityBreaking
+				if safeInputChange(oldArg.Type, newArg.Type) {
+					criticality = CriticalitySafe
+				}
+				d.add("FIELD_ARGUMENT_TYPE_CHANGED", criticality, argPath,
+					"Type for argument '%s' on field '%s' changed from '%s' to '%s'",
+					oldArg.Name, coordinate, oldArg.Type, newArg.Type)
+			}
+
+			if oldDefault, newDefault := formatValue(oldArg.DefaultValue), formatValue(newArg.DefaultValue); oldDefault != newDefault {
+				d.add("FIELD_ARGUMENT_DEFAULT_CHANGED", CriticalityDangerous, argPath,
+					"Default value for argument '%s' on field '%s' changed from '%s' to '%s'",
+					oldArg.Name, coordinate, oldDefault, newDefault)
+			}
+
+			if oldArg.Description != newArg.Description {
+				d.add("FIELD_ARGUMENT_DESCRIPTION_CHANGED", CriticalitySafe, argPath,
+					"Description for argument '%s' on field '%s' changed from '%s' to '%s'",
+					oldArg.Name, coordinate, oldArg.Description, newArg.Description)
+			}
+		}
+
+		for _, newArg := range newField.Arguments {
+			if oldField.Arguments.ForName(newArg.Name) != nil {
+				continue
+			}
+
+			criticality := CriticalityDangerous
+			if newArg.Type.NonNull && newArg.DefaultValue == nil {
+				criticality = CriticalityBreaking
+			}
+			d.add("FIELD_ARGUMENT_ADDED", criticality, []string{oldType.Name, oldField.Name, newArg.Name},
+				"Argument '%s: %s' added to field '%s'", newArg.Name, newArg.Type, coordinate)
+		}
+	}
+
+	for _, newField := range newType.Fields {
+		if oldType.Fields.ForName(newField.Name) == nil {
+			d.add("FIELD_ADDED", CriticalitySafe, []string{oldType.Name, newField.Name},
+				"Field '%s' was added to %s '%s'", newField.Name, kind, oldType.Name)
+		}
+	}
+}
+
+func (d *schemaDiff) compareInputFields(oldType *ast.Definition, newType *ast.Definition) {
+	for _, oldField := range oldType.Fields {
+		path := []string{oldType.Name, oldField.Name}
+		coordinate := oldType.Name + "." + oldField.Name
+
+		newField := newType.Fields.ForName(oldField.Name)
+		if newField == nil {
+			d.add("INPUT_FIELD_REMOVED", CriticalityBreaking, path,
+				"Input field '%s' was removed from input object type '%s'", oldField.Name, oldType.Name)
+			continue
+		}
+
+		if oldField.Type.String() != newField.Type.String() {
+			criticality := CriticalityBreaking
+			if safeInputChange(oldField.Type, newField.Type) {
+				criticality = CriticalitySafe
+			}
+			d.add("INPUT_FIELD_TYPE_CHANGED", criticality, path,
+				"Input field '%s' changed type from '%s' to '%s'", coordinate, oldField.Type, newField.Type)
+		}
+
+		if oldDefault, newDefault := formatValue(oldField.DefaultValue), formatValue(newField.DefaultValue); oldDefault != newDefault {
+			d.add("INPUT_FIELD_DEFAULT_VALUE_CHANGED", CriticalityDangerous, path,
+				"Input field '%s' default value changed from '%s' to '%s'", coordinate, oldDefault, newDefault)
+		}
+
+		if oldField.Description != newField.Description {
+			d.add("INPUT_FIELD_DESCRIPTION_CHANGED", CriticalitySafe, path,
+				"Input field '%s' description changed from '%s' to '%s'", coordinate, oldField.Description, newField.Description)
+		}
+	}
+
+	for _, newField := range newType.Fields {
+		if oldType.Fields.ForName(newField.Name) != nil {
+			continue
+		}
+
+		criticality := CriticalityDangerous
+		if newField.Type.NonNull && newField.DefaultValue == nil {
+			criticality = CriticalityBreaking
+		}
+		d.add("INPUT_FIELD_ADDED", criticality, []string{oldType.Name, newField.Name},
+			"Input field '%s' of type '%s' was added to input object type '%s'", newField.Name, newField.Type, oldType.Name)
+	}
+}
+
+func (d *schemaDiff) compareEnumValues(oldType *ast.Definition, newType *ast.Definition) {
+	for _, oldValue := range oldType.EnumValues {
+		path := []string{oldType.Name, oldValue.Name}
+
+		newValue := newType.EnumValues.ForName(oldValue.Name)
+		if newValue == nil {
+			d.add("ENUM_VALUE_REMOVED", CriticalityBreaking, path,
+				"Enum value '%s' was removed from enum '%s'", oldValue.Name, oldType.Name)
+			continue
+		}
+
+		d.compareDescription("ENUM_VALUE", path, oldValue.Description, newValue.Description,
+			"enum value '"+oldType.Name+"."+oldValue.Name+"'")
+		d.compareDeprecation("ENUM_VALUE", path, oldValue.Directives, newValue.Directives,
+			"enum value '"+oldType.Name+"."+oldValue.Name+"'")
+	}
+
+	for _, newValue := range newType.EnumValues {
+		if oldType.EnumValues.ForName(newValue.Name) == nil {
+			d.add("ENUM_VALUE_ADDED", CriticalityDangerous, []string{oldType.Name, newValue.Name},
+				"Enum value '%s' was added to enum '%s'", newValue.Name, oldType.Name)
+		}
+	}
+}
+
+func (d *schemaDiff) compareUnionMembers(oldType *ast.Definition, newType *ast.Definition) {
+	path := []string{oldType.Name}
+
+	for _, name := range oldType.Types {
+		if !slices.Contains(newType.Types, name) {
+			d.add("UNION_MEMBER_REMOVED", CriticalityBreaking, path,
+				"Member '%s' was removed from Union type '%s'", name, oldType.Name)
+		}
+	}
+
+	for _, name := range newType.Types {
+		if !slices.Contains(oldType.Types, name) {
+			d.add("UNION_MEMBER_ADDED", CriticalityDangerous, path,
+				"Member '%s' was added to Union type '%s'", name, oldType.Name)
+		}
+	}
+}
+
+func (d *schemaDiff) compareDirectives(oldSchema *schemaIndex, newSchema *schemaIndex) {
+	for _, name := range sortedKeys(oldSchema.directives, newSchema.directives) {
+		oldDirective, newDirective := oldSchema.directives[name], newSchema.directives[name]
+		path := []string{"@" + name}
+
+		switch {
+		case newDirective == nil:
+			d.add("DIRECTIVE_REMOVED", CriticalityBreaking, path, "Directive '%s' was removed", name)
+			continue
+		case oldDirective == nil:
+			d.add("DIRECTIVE_ADDED", CriticalitySafe, path, "Directive '%s' was added", name)
+			continue
+		}
+
+		d.compareDescription("DIRECTIVE", path, oldDirective.Description, newDirective.Description,
+			"directive '"+name+"'")
+
+		switch {
+		case oldDirective.IsRepeatable && !newDirective.IsRepeatable:
+			d.add("DIRECTIVE_REPEATABLE_REMOVED", CriticalityBreaking, path,
+				"Repeatable flag was removed from directive '%s'", name)
+		case !oldDirective.IsRepeatable && newDirective.IsRepeatable:
+			d.add("DIRECTIVE_REPEATABLE_ADDED", CriticalitySafe, path,
+				"Repeatable flag was added to directive '%s'", name)
+		}
+
+		for _, location := range oldDirective.Locations {
+			if !slices.Contains(newDirective.Locations, location) {
+				d.add("DIRECTIVE_LOCATION_REMOVED", CriticalityBreaking, path,
+					"Location '%s' was removed from directive '%s'", location, name)
+			}
+		}
+		for _, location := range newDirective.Locations {
+			if !slices.Contains(oldDirective.Locations, location) {
+				d.add("DIRECTIVE_LOCATION_ADDED", CriticalitySafe, path,
+					"Location '%s' was added to directive '%s'", location, name)
+			}
+		}
+
+		for _, oldArg := range oldDirective.Arguments {
+			argPath := []string{"@" + name, oldArg.Name}
+
+			newArg := newDirective.Arguments.ForName(oldArg.Name)
+			if newArg == nil {
+				d.add("DIRECTIVE_ARGUMENT_REMOVED", CriticalityBreaking, argPath,
+					"Argument '%s' was removed from directive '%s'", oldArg.Name, name)
+				continue
+			}
+
+			if oldArg.Type.String() != newArg.Type.String() {
+				criticality := CriticalityBreaking
+				if safeInputChange(oldArg.Type, newArg.Type) {
+					criticality = CriticalitySafe
+				}
+				d.add("DIRECTIVE_ARGUMENT_TYPE_CHANGED", criticality, argPath,
+					"Type for argument '%s' on directive '%s' changed from '%s' to '%s'",
+					oldArg.Name, name, oldArg.Type, newArg.Type)
+			}
+
+			if oldDefault, newDefault := formatValue(oldArg.DefaultValue), formatValue(newArg.DefaultValue); oldDefault != newDefault {
+				d.add("DIRECTIVE_ARGUMENT_DEFAULT_VALUE_CHANGED", CriticalityDangerous, argPath,
+					"Default value for argument '%s' on directive '%s' changed from '%s' to '%s'",
+					oldArg.Name, name, oldDefault, newDefault)
+			}
+
+			if oldArg.Description != newArg.Description {
+				d.add("DIRECTIVE_ARGUMENT_DESCRIPTION_CHANGED", CriticalitySafe, argPath,
+					"Description for argument '%s' on directive '%s' changed from '%s' to '%s'",
+					oldArg.Name, name, oldArg.Description, newArg.Description)
+			}
+		}
+		for _, newArg := range newDirective.Arguments {
+			if oldDirective.Arguments.ForName(newArg.Name) != nil {
+				continue
+			}
+
+			criticality := CriticalitySafe
+			if newArg.Type.NonNull && newArg.DefaultValue == nil {
+				criticality = CriticalityBreaking
+			}
+			d.add("DIRECTIVE_ARGUMENT_ADDED", criticality, []string{"@" + name, newArg.Name},
+				"Argument '%s' was added to directive '%s'", newArg.Name, name)
+		}
+	}
+}
+
+// compareDescription reports an added, removed or changed description, which
+// is always safe.
+func (d *schemaDiff) compareDescription(prefix string, path []string, oldDescription string, newDescription string, subject string) {
+	switch {
+	case oldDescription == newDescription:
+	case oldDescription == "":
+		d.add(prefix+"_DESCRIPTION_ADDED", CriticalitySafe, path,
+			"Description '%s' was added to %s", newDescription, subject)
+	case newDescription == "":
+		d.add(prefix+"_DESCRIPTION_REMOVED", CriticalitySafe, path,
+			"Description '%s' was removed from %s", oldDescription, subject)
+	default:
+		d.add(prefix+"_DESCRIPTION_CHANGED", CriticalitySafe, path,
+			"Description of %s changed from '%s' to '%s'", subject, oldDescription, newDescription)
+	}
+}
+
+// compareDeprecation reports an added, removed or changed @deprecated
+// directive, which is always safe.
+func (d *schemaDiff) compareDeprecation(prefix string, path []string, oldDirectives ast.DirectiveList, newDirectives ast.DirectiveList, subject string) {
+	oldReason, oldDeprecated := deprecationReason(oldDirectives)
+	newReason, newDeprecated := deprecationReason(newDirectives)
+
+	switch {
+	case !oldDeprecated && newDeprecated:
+		d.add(prefix+"_DEPRECATION_ADDED", CriticalitySafe, path,
+			"%s is deprecated with reason '%s'", capitalize(subject), newReason)
+	case oldDeprecated && !newDeprecated:
+		d.add(prefix+"_DEPRECATION_REMOVED", CriticalitySafe, path,
+			"%s is no longer deprecated", capitalize(subject))
+	case oldDeprecated && oldReason != newReason:
+		d.add(prefix+"_DEPRECATION_REASON_CHANGED", CriticalitySafe, path,
+			"Deprecation reason on %s has changed from '%s' to '%s'", subject, oldReason, newReason)
+	}
+}
+
+func deprecationReason(directives ast.DirectiveList) (string, bool) {
+	directive := directives.ForName("deprecated")
+	if directive == nil {
+		return "", false
+	}
+
+	if arg := directive.Arguments.ForName("reason"); arg != nil && arg.Value != nil {
+		return arg.Value.Raw, true
+	}
+	return defaultDeprecationReason, true
+}
+
+// safeOutputChange reports whether clients reading a field of the old type can
+// also read the new type, which is the case when it only became stricter.
+func safeOutputChange(oldType *ast.Type, newType *ast.Type) bool {
+	if oldType.NonNull && !newType.NonNull {
+		return false
+	}
+	if (oldType.Elem == nil) != (newType.Elem == nil) {
+		return false
+	}
+	if oldType.Elem != nil {
+		return safeOutputChange(oldType.Elem, newType.Elem)
+	}
+	return oldType.NamedType == newType.NamedType
+}
+
+// safeInputChange reports whether clients sending the old type are still
+// accepted by the new type, which is the case when it only became looser.
+func safeInputChange(oldType *ast.Type, newType *ast.Type) bool {
+	if !oldType.NonNull && newType.NonNull {
+		return false
+	}
+	if (oldType.Elem == nil) != (newType.Elem == nil) {
+		return false
+	}
+	if oldType.Elem != nil {
+		return safeInputChange(oldType.Elem, newType.Elem)
+	}
+	return oldType.NamedType == newType.NamedType
+}
+
+func kindName(kind ast.DefinitionKind) string {
+	switch kind {
+	case ast.Interface:
+		return "interface type"
+	case ast.InputObject:
+		return "input object type"
+	}
+	return "object type"
+}
+
+// capitalize returns the subject with its first letter in upper case, for use
+// at the start of a message.
+func capitalize(subject string) string {
+	if subject == "" {
+		return subject
+	}
+	return strings.ToUpper(subject[:1]) + subject[1:]
+}
+
+func formatValue(value *ast.Value) string {
+	if value == nil {
+		return "undefined"
+	}
+	return value.String()
+}
+
+func sortedKeys[T any](a map[string]T, b map[string]T) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,245 @@
+package sdk
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func change(changeType string, criticality string, path ...string) SchemaChange {
+	return SchemaChange{Type: changeType, Criticality: criticality, Path: path}
+}
+
+func TestDiffSchemas(t *testing.T) {
+	tests := []struct {
+		name string
+		old  string
+		new  string
+		want []SchemaChange
+	}{
+		{
+			name: "unchanged",
+			old:  `type Query { a(b: Int = 1): [String!] @deprecated } enum E { A }`,
+			new:  "type Query {\n  a(b: Int = 1): [String!] @deprecated\n}\n\nenum E {\n  A\n}",
+			want: []SchemaChange{},
+		},
+		{
+			name: "type extensions are merged",
+			old:  `type Query { a: Int } extend type Query { b: Int }`,
+			new:  `type Query { a: Int b: Int }`,
+			want: []SchemaChange{},
+		},
+		{
+			name: "root types",
+			old:  `schema { query: Query } type Query { a: Int } type Other { a: Int } type Mutation { a: Int }`,
+			new:  `schema { query: Other mutation: Mutation } type Query { a: Int } type Other { a: Int } type Mutation { a: Int }`,
+			want: []SchemaChange{
+				change("SCHEMA_QUERY_TYPE_CHANGED", CriticalityBreaking),
+				change("SCHEMA_MUTATION_TYPE_CHANGED", CriticalitySafe),
+			},
+		},
+		{
+			name: "types",
+			old:  `type Query { a: Int } type Removed { a: Int } type Changed { a: Int }`,
+			new:  `type Query { a: Int } type Added { a: Int } input Changed { a: Int }`,
+			want: []SchemaChange{
+				change("TYPE_ADDED", CriticalitySafe, "Added"),
+				change("TYPE_KIND_CHANGED", CriticalityBreaking, "Changed"),
+				change("TYPE_REMOVED", CriticalityBreaking, "Removed"),
+			},
+		},
+		{
+			name: "type descriptions",
+			old:  `type A { a: Int } "b" type B { a: Int } "c" type C { a: Int }`,
+			new:  `"a" type A { a: Int } type B { a: Int } "changed" type C { a: Int }`,
+			want: []SchemaChange{
+				change("TYPE_DESCRIPTION_ADDED", CriticalitySafe, "A"),
+				change("TYPE_DESCRIPTION_REMOVED", CriticalitySafe, "B"),
+				change("TYPE_DESCRIPTION_CHANGED", CriticalitySafe, "C"),
+			},
+		},
+		{
+			name: "interfaces",
+			old:  `interface A { a: Int } interface B { a: Int } type T implements A { a: Int }`,
+			new:  `interface A { a: Int } interface B { a: Int } type T implements B { a: Int }`,
+			want: []SchemaChange{
+				change("OBJECT_TYPE_INTERFACE_REMOVED", CriticalityBreaking, "T"),
+				change("OBJECT_TYPE_INTERFACE_ADDED", CriticalityDangerous, "T"),
+			},
+		},
+		{
+			name: "fields",
+			old:  `type T { removed: Int kept: Int }`,
+			new:  `type T { kept: Int added: Int }`,
+			want: []SchemaChange{
+				change("FIELD_REMOVED", CriticalityBreaking, "T", "removed"),
+				change("FIELD_ADDED", CriticalitySafe, "T", "added"),
+			},
+		},
+		{
+			name: "field types",
+			old:  `type T { stricter: Int looser: Int! list: [Int] listItem: [Int] named: Int }`,
+			new:  `type T { stricter: Int! looser: Int list: Int listItem: [Int!] named: String }`,
+			want: []SchemaChange{
+				change("FIELD_TYPE_CHANGED", CriticalitySafe, "T", "stricter"),
+				change("FIELD_TYPE_CHANGED", CriticalityBreaking, "T", "looser"),
+				change("FIELD_TYPE_CHANGED", CriticalityBreaking, "T", "list"),
+				change("FIELD_TYPE_CHANGED", CriticalitySafe, "T", "listItem"),
+				change("FIELD_TYPE_CHANGED", CriticalityBreaking, "T", "named"),
+			},
+		},
+		{
+			name: "field descriptions and deprecations",
+			old:  `type T { a: Int "b" b: Int c: Int d: Int @deprecated e: Int @deprecated(reason: "old") }`,
+			new:  `type T { "a" a: Int b: Int c: Int @deprecated d: Int e: Int @deprecated(reason: "new") }`,
+			want: []SchemaChange{
+				change("FIELD_DESCRIPTION_ADDED", CriticalitySafe, "T", "a"),
+				change("FIELD_DESCRIPTION_REMOVED", CriticalitySafe, "T", "b"),
+				change("FIELD_DEPRECATION_ADDED", CriticalitySafe, "T", "c"),
+				change("FIELD_DEPRECATION_REMOVED", CriticalitySafe, "T", "d"),
+				change("FIELD_DEPRECATION_REASON_CHANGED", CriticalitySafe, "T", "e"),
+			},
+		},
+		{
+			name: "field arguments",
+			old:  `type T { f(removed: Int, looser: Int!, stricter: Int, default: Int = 1, "old" description: Int): Int }`,
+			new: `type T { f(looser: Int, stricter: Int!, default: Int = 2, "new" description: Int,
+				optional: Int, required: Int!, requiredWithDefault: Int! = 1): Int }`,
+			want: []SchemaChange{
+				change("FIELD_ARGUMENT_REMOVED", CriticalityBreaking, "T", "f", "removed"),
+				change("FIELD_ARGUMENT_TYPE_CHANGED", CriticalitySafe, "T", "f", "looser"),
+				change("FIELD_ARGUMENT_TYPE_CHANGED", CriticalityBreaking, "T", "f", "stricter"),
+				change("FIELD_ARGUMENT_DEFAULT_CHANGED", CriticalityDangerous, "T", "f", "default"),
+				change("FIELD_ARGUMENT_DESCRIPTION_CHANGED", CriticalitySafe, "T", "f", "description"),
+				change("FIELD_ARGUMENT_ADDED", CriticalityDangerous, "T", "f", "optional"),
+				change("FIELD_ARGUMENT_ADDED", CriticalityBreaking, "T", "f", "required"),
+				change("FIELD_ARGUMENT_ADDED", CriticalityDangerous, "T", "f", "requiredWithDefault"),
+			},
+		},
+		{
+			name: "input fields",
+			old:  `input I { removed: Int looser: Int! stricter: Int default: Int = 1 "old" description: Int }`,
+			new: `input I { looser: Int stricter: Int! default: Int = 2 "new" description: Int
+				optional: Int required: Int! requiredWithDefault: Int! = 1 }`,
+			want: []SchemaChange{
+				change("INPUT_FIELD_REMOVED", CriticalityBreaking, "I", "removed"),
+				change("INPUT_FIELD_TYPE_CHANGED", CriticalitySafe, "I", "looser"),
+				change("INPUT_FIELD_TYPE_CHANGED", CriticalityBreaking, "I", "stricter"),
+				change("INPUT_FIELD_DEFAULT_VALUE_CHANGED", CriticalityDangerous, "I", "default"),
+				change("INPUT_FIELD_DESCRIPTION_CHANGED", CriticalitySafe, "I", "description"),
+				change("INPUT_FIELD_ADDED", CriticalityDangerous, "I", "optional"),
+				change("INPUT_FIELD_ADDED", CriticalityBreaking, "I", "required"),
+				change("INPUT_FIELD_ADDED", CriticalityDangerous, "I", "requiredWithDefault"),
+			},
+		},
+		{
+			name: "enum values",
+			old:  `enum E { REMOVED KEPT DEPRECATED "old" DESCRIBED }`,
+			new:  `enum E { KEPT DEPRECATED @deprecated(reason: "gone") "new" DESCRIBED ADDED }`,
+			want: []SchemaChange{
+				change("ENUM_VALUE_REMOVED", CriticalityBreaking, "E", "REMOVED"),
+				change("ENUM_VALUE_DEPRECATION_ADDED", CriticalitySafe, "E", "DEPRECATED"),
+				change("ENUM_VALUE_DESCRIPTION_CHANGED", CriticalitySafe, "E", "DESCRIBED"),
+				change("ENUM_VALUE_ADDED", CriticalityDangerous, "E", "ADDED"),
+			},
+		},
+		{
+			name: "union members",
+			old:  `type A { a: Int } type B { a: Int } type C { a: Int } union U = A | B`,
+			new:  `type A { a: Int } type B { a: Int } type C { a: Int } union U = A | C`,
+			want: []SchemaChange{
+				change("UNION_MEMBER_REMOVED", CriticalityBreaking, "U"),
+				change("UNION_MEMBER_ADDED", CriticalityDangerous, "U"),
+			},
+		},
+		{
+			name: "directives",
+			old:  `directive @removed on FIELD_DEFINITION directive @kept on FIELD_DEFINITION`,
+			new:  `directive @kept on FIELD_DEFINITION directive @added on FIELD_DEFINITION`,
+			want: []SchemaChange{
+				change("DIRECTIVE_ADDED", CriticalitySafe, "@added"),
+				change("DIRECTIVE_REMOVED", CriticalityBreaking, "@removed"),
+			},
+		},
+		{
+			name: "directive definitions",
+			old:  `"old" directive @a repeatable on FIELD_DEFINITION | OBJECT directive @b on OBJECT`,
+			new:  `"new" directive @a on FIELD_DEFINITION | INTERFACE directive @b repeatable on OBJECT`,
+			want: []SchemaChange{
+				change("DIRECTIVE_DESCRIPTION_CHANGED", CriticalitySafe, "@a"),
+				change("DIRECTIVE_REPEATABLE_REMOVED", CriticalityBreaking, "@a"),
+				change("DIRECTIVE_LOCATION_REMOVED", CriticalityBreaking, "@a"),
+				change("DIRECTIVE_LOCATION_ADDED", CriticalitySafe, "@a"),
+				change("DIRECTIVE_REPEATABLE_ADDED", CriticalitySafe, "@b"),
+			},
+		},
+		{
+			name: "directive arguments",
+			old:  `directive @d(removed: Int, looser: Int!, stricter: Int, default: Int = 1, "old" description: Int) on OBJECT`,
+			new:  `directive @d(looser: Int, stricter: Int!, default: Int = 2, "new" description: Int, optional: Int, required: Int!) on OBJECT`,
+			want: []SchemaChange{
+				change("DIRECTIVE_ARGUMENT_REMOVED", CriticalityBreaking, "@d", "removed"),
+				change("DIRECTIVE_ARGUMENT_TYPE_CHANGED", CriticalitySafe, "@d", "looser"),
+				change("DIRECTIVE_ARGUMENT_TYPE_CHANGED", CriticalityBreaking, "@d", "stricter"),
+				change("DIRECTIVE_ARGUMENT_DEFAULT_VALUE_CHANGED", CriticalityDangerous, "@d", "default"),
+				change("DIRECTIVE_ARGUMENT_DESCRIPTION_CHANGED", CriticalitySafe, "@d", "description"),
+				change("DIRECTIVE_ARGUMENT_ADDED", CriticalitySafe, "@d", "optional"),
+				change("DIRECTIVE_ARGUMENT_ADDED", CriticalityBreaking, "@d", "required"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := DiffSchemas(tt.old, tt.new)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := make([]SchemaChange, 0, len(changes))
+			for _, c := range changes {
+				if c.Message == "" {
+					t.Errorf("change %s has no message", c.Type)
+				}
+				c.Message = ""
+				got = append(got, c)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("unexpected changes\n got: %v\nwant: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffSchemasMessages(t *testing.T) {
+	changes, err := DiffSchemas(
+		`type Query { a(b: Int): Int c: Int }`,
+		`type Query { a(b: Int!): Int! c: Int @deprecated }`,
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []string{
+		"Field 'Query.a' changed type from 'Int' to 'Int!'",
+		"Type for argument 'b' on field 'Query.a' changed from 'Int' to 'Int!'",
+		"Field 'Query.c' is deprecated with reason 'No longer supported'",
+	}
+
+	got := make([]string, 0, len(changes))
+	for _, c := range changes {
+		got = append(got, c.Message)
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected messages\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestDiffSchemasSyntaxError(t *testing.T) {
+	_, err := DiffSchemas(`type Query { a: Int }`, `type Query {`)
+	if err == nil || !strings.HasPrefix(err.Error(), "new schema: ") {
+		t.Errorf("expected a syntax error for the new schema, got: %v", err)
+	}
+}
@@ -0,0 +1,147 @@
+package sdk
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// SchemaSyntaxError describes why a schema doesn't parse.
+type SchemaSyntaxError struct {
+	Message string
+	Line    int
+	Column  int
+}
+
+func (e SchemaSyntaxError) Error() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Message)
+}
+
+// NormalizeSchema returns the canonical printed form of the schema, without
+// comments, so schemas that only differ in their formatting are normalized to
+// the same string. It returns a SchemaSyntaxError when the schema doesn't
+// parse.
+func NormalizeSchema(sdl string) (string, error) {
+	doc, err := parseSchema(sdl)
+	if err != nil {
+		return "", err
+	}
+
+	normalized := printSchema(doc)
+
+	// The printed schema has to parse to the same document, otherwise the
+	// normalized schema would silently differ from the original.
+	reparsed, err := parseSchema(normalized)
+	if err != nil || printSchema(reparsed) != normalized {
+		return "", fmt.Errorf("the schema can't be printed without changing it")
+	}
+	return normalized, nil
+}
+
+// printSchema prints the schema document, leaving out the comments. The
+// formatter of gqlparser prints the directives of schema definitions in the
+// wrong place and doesn't escape descriptions, so those are handled here.
+func printSchema(doc *ast.SchemaDocument) string {
+	escapeDescriptions(doc)
+
+	var buf bytes.Buffer
+	printSchemaDefinitions(&buf, doc.Schema, false)
+	printSchemaDefinitions(&buf, doc.SchemaExtension, true)
+
+	rest := *doc
+	rest.Schema = nil
+	rest.SchemaExtension = nil
+	formatter.NewFormatter(&buf, formatter.WithIndent("  ")).FormatSchemaDocument(&rest)
+
+	return strings.TrimSpace(buf.String())
+}
+
+func printSchemaDefinitions(buf *bytes.Buffer, defs ast.SchemaDefinitionList, extension bool) {
+	for _, def := range defs {
+		if def.Description != "" {
+			fmt.Fprintf(buf, "\"\"\"\n%s\n\"\"\"\n", def.Description)
+		}
+		if extension {
+			buf.WriteString("extend ")
+		}
+		buf.WriteString("schema")
+		for _, directive := range def.Directives {
+			buf.WriteString(" @" + directive.Name)
+			if len(directive.Arguments) > 0 {
+				args := make([]string, 0, len(directive.Arguments))
+				for _, arg := range directive.Arguments {
+					args = append(args, arg.Name+": "+arg.Value.String())
+				}
+				buf.WriteString("(" + strings.Join(args, ", ") + ")")
+			}
+		}
+		if len(def.OperationTypes) > 0 {
+			buf.WriteString(" {\n")
+			for _, operationType := range def.OperationTypes {
+				fmt.Fprintf(buf, "  %s: %s\n", operationType.Operation, operationType.Type)
+			}
+			buf.WriteString("}")
+		}
+		buf.WriteString("\n")
+	}
+}
+
+// escapeDescriptions escapes the triple quotes in all descriptions of the
+// document, as they are printed as block strings.
+func escapeDescriptions(doc *ast.SchemaDocument) {
+	escape := func(description *string) {
+		*description = strings.ReplaceAll(*description, `"""`, `\"""`)
+	}
+
+	for _, def := range append(doc.Schema, doc.SchemaExtension...) {
+		escape(&def.Description)
+	}
+	for _, directive := range doc.Directives {
+		escape(&directive.Description)
+		for _, arg := range directive.Arguments {
+			escape(&arg.Description)
+		}
+	}
+	for _, def := range append(doc.Definitions, doc.Extensions...) {
+		escape(&def.Description)
+		for _, field := range def.Fields {
+			escape(&field.Description)
+			for _, arg := range field.Arguments {
+				escape(&arg.Description)
+			}
+		}
+		for _, value := range def.EnumValues {
+			escape(&value.Description)
+		}
+	}
+}
+
+// parseSchema parses the schema without validating it, so schemas using
+// directives that aren't defined in the schema itself, such as subgraphs,
+// can still be parsed.
+func parseSchema(sdl string) (*ast.SchemaDocument, error) {
+	doc, err := parser.ParseSchema(&ast.Source{Name: "schema.graphql", Input: sdl})
+	if err == nil {
+		return doc, nil
+	}
+
+	gqlErr, ok := err.(*gqlerror.Error)
+	if !ok {
+		return nil, SchemaSyntaxError{Message: err.Error()}
+	}
+
+	result := SchemaSyntaxError{Message: gqlErr.Message}
+	if len(gqlErr.Locations) > 0 {
+		result.Line = gqlErr.Locations[0].Line
+		result.Column = gqlErr.Locations[0].Column
+	}
+	return nil, result
+}
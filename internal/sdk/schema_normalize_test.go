@@ -0,0 +1,149 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNormalizeSchema(t *testing.T) {
+	tests := []struct {
+		name string
+		sdl  string
+		want string
+	}{
+		{
+			name: "formatting and comments",
+			sdl: `
+				# The root type
+				type Query {
+					a: Int    # a comment
+					b(  id: ID! ): String
+				}`,
+			want: "type Query {\n  a: Int\n  b(id: ID!): String\n}",
+		},
+		{
+			name: "hash signs in strings are kept",
+			sdl:  `type Query { a(format: String = "#,##0"): String @deprecated(reason: "use # b") }`,
+			want: "type Query {\n  a(format: String = \"#,##0\"): String @deprecated(reason: \"use # b\")\n}",
+		},
+		{
+			name: "descriptions",
+			sdl:  `"""The root type with \""" quotes""" type Query { "A field" a: Int }`,
+			want: "\"\"\"\nThe root type with \\\"\"\" quotes\n\"\"\"\ntype Query {\n  \"\"\"\n  A field\n  \"\"\"\n  a: Int\n}",
+		},
+		{
+			name: "schema directives",
+			sdl:  `extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key"]) type Query { a: Int }`,
+			want: "extend schema @link(url: \"https://specs.apollo.dev/federation/v2.3\", import: [\"@key\"])\ntype Query {\n  a: Int\n}",
+		},
+		{
+			name: "schema definition",
+			sdl:  `schema { query: Root } type Root { a: Int }`,
+			want: "schema {\n  query: Root\n}\ntype Root {\n  a: Int\n}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeSchema(tt.sdl)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("unexpected schema\n got: %q\nwant: %q", got, tt.want)
+			}
+
+			again, err := NormalizeSchema(got)
+			if err != nil {
+				t.Fatalf("unexpected error normalizing the normalized schema: %v", err)
+			}
+			if again != got {
+				t.Errorf("normalizing is not idempotent\n got: %q\nwant: %q", again, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeSchemaSyntaxError(t *testing.T) {
+	_, err := NormalizeSchema("type Query {\n  a: \n}")
+
+	syntaxErr, ok := err.(SchemaSyntaxError)
+	if !ok {
+		t.Fatalf("got error %T %v, want a SchemaSyntaxError", err, err)
+	}
+	if syntaxErr.Line != 3 {
+		t.Errorf("got line %d, want 3", syntaxErr.Line)
+	}
+}
+
+// TestSchemaSentNormalized checks that schemas are published and checked in
+// the form returned by NormalizeSchema.
+func TestSchemaSentNormalized(t *testing.T) {
+	const sdl = `
+		# The root type
+		type Query {
+			a: Int    # a comment
+			b(format: String = "#,##0"): String
+		}`
+
+	want, err := NormalizeSchema(sdl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		send func(hc *HiveClient) error
+	}{
+		{
+			name: "publish",
+			send: func(hc *HiveClient) error {
+				_, err := hc.SchemaPublish(context.Background(), &SchemaPublishInput{Schema: sdl, Author: "a", Commit: "c"})
+				return err
+			},
+		},
+		{
+			name: "check",
+			send: func(hc *HiveClient) error {
+				_, err := hc.SchemaCheck(context.Background(), &SchemaCheckInput{Schema: sdl, Author: "a", Commit: "c"})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent *string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				payload := struct {
+					Variables struct {
+						Input struct {
+							Sdl string `json:"sdl"`
+						} `json:"input"`
+					} `json:"variables"`
+				}{}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Errorf("unexpected request body: %v", err)
+				}
+				sent = &payload.Variables.Input.Sdl
+
+				// Only the request matters, so the response is an error.
+				w.WriteHeader(http.StatusBadRequest)
+			}))
+			defer server.Close()
+
+			hc := NewHiveClient(&http.Client{Transport: http.DefaultTransport}, server.URL, "", "", "", "token", "test")
+			_ = tt.send(hc)
+
+			if sent == nil {
+				t.Fatal("no schema was sent")
+			}
+			if *sent != want {
+				t.Errorf("sent schema differs from the normalized schema\n got: %q\nwant: %q", *sent, want)
+			}
+		})
+	}
+}
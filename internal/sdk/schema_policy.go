@@ -0,0 +1,46 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+type SchemaPolicyRule struct {
+	Id               string
+	Description      string
+	DocumentationUrl *string
+	Recommended      bool
+	// ConfigJSONSchema is the JSON schema of the rule configuration, or nil
+	// when the rule can't be configured.
+	ConfigJSONSchema *string
+}
+
+/**
+ * SchemaPolicyRules() fetches the rules that are available for schema
+ * policies.
+ */
+func (hc *HiveClient) SchemaPolicyRules(ctx context.Context) ([]SchemaPolicyRule, error) {
+	data, err := client.SchemaPolicyRules(ctx, *hc.client)
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	result := make([]SchemaPolicyRule, 0, len(data.SchemaPolicyRules))
+	for _, rule := range data.SchemaPolicyRules {
+		var configJSONSchema *string
+		if raw := rule.GetConfigJsonSchema(); len(raw) > 0 && string(raw) != "null" {
+			value := string(raw)
+			configJSONSchema = &value
+		}
+
+		result = append(result, SchemaPolicyRule{
+			Id:               rule.GetId(),
+			Description:      rule.GetDescription(),
+			DocumentationUrl: rule.GetDocumentationUrl(),
+			Recommended:      rule.GetRecommended(),
+			ConfigJSONSchema: configJSONSchema,
+		})
+	}
+	return result, nil
+}
@@ -28,14 +28,25 @@ type SchemaPublishResult struct {
 }
 
 func (hc *HiveClient) SchemaPublish(ctx context.Context, input *SchemaPublishInput) (*SchemaPublishResult, error) {
+	target, err := hc.getTarget(input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	// The schema is sent in the same form as the normalize_schema function
+	// returns, so both can be compared.
+	sdl, err := NormalizeSchema(input.Schema)
+	if err != nil {
+		return nil, newError(ErrorKindValidation, "invalid schema: %s", err)
+	}
 
 	vars := client.SchemaPublishInput{
 		Service: input.Service,
 		Commit:  input.Commit,
 		Author:  input.Author,
-		Sdl:     minifySchema(input.Schema),
+		Sdl:     sdl,
 		Url:     input.URL,
-		Target:  getTarget(ctx, hc.Organization, input.Project, input.Target),
+		Target:  target,
 	}
 
 	// Try to get the latest commit info from git if it's not provided.
@@ -55,7 +66,7 @@ func (hc *HiveClient) SchemaPublish(ctx context.Context, input *SchemaPublishInp
 
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("error: %v", err))
-		return nil, err
+		return nil, wrapRequestError(err)
 	}
 
 	switch v := data.SchemaPublish.(type) {
@@ -83,13 +94,13 @@ func (hc *HiveClient) SchemaPublish(ctx context.Context, input *SchemaPublishInp
 		return &result, nil
 
 	case *client.SchemaPublishSchemaPublishSchemaPublishMissingServiceError:
-		return nil, fmt.Errorf("hive error: %s", v.GetMessage())
+		return nil, newResultError("hive error: %s", v.GetMessage())
 
 	case *client.SchemaPublishSchemaPublishSchemaPublishMissingUrlError:
-		return nil, fmt.Errorf("hive error: %s", v.GetMessage())
+		return nil, newResultError("hive error: %s", v.GetMessage())
 
 	case *client.SchemaPublishSchemaPublishGitHubSchemaPublishError:
-		return nil, fmt.Errorf("hive error: %s", v.GetMessage())
+		return nil, newResultError("hive error: %s", v.GetMessage())
 	}
 
 	return nil, fmt.Errorf("unexpected type %T", data.SchemaPublish)
@@ -0,0 +1,185 @@
+package sdk
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+type UnusedSchemaInput struct {
+	Project string
+	Target  string
+	From    time.Time
+	To      time.Time
+}
+
+type UnusedSchema struct {
+	// SchemaVersionId is the ID of the latest valid schema version, which the
+	// usage is based on.
+	SchemaVersionId string
+	// Types are the names of the types that weren't used within the period.
+	Types []string
+	// Coordinates are the fields, arguments, input fields and enum values that
+	// weren't used within the period.
+	Coordinates []string
+	// Deprecated are the deprecated fields, arguments, input fields and enum
+	// values, whether used or not.
+	Deprecated []DeprecatedCoordinate
+}
+
+type DeprecatedCoordinate struct {
+	Coordinate string
+	Reason     *string
+	IsUsed     bool
+	Count      int64
+	// Clients are the names of the clients that used the coordinate.
+	Clients []string
+}
+
+// explorerMember is a field, argument, input field or enum value of a type
+// returned by the schema explorer.
+type explorerMember struct {
+	coordinate   string
+	isDeprecated bool
+	reason       *string
+	usage        client.ExplorerUsage
+}
+
+/**
+ * UnusedSchema() fetches the types and coordinates of the latest valid schema
+ * version of the target that weren't used within the period, together with
+ * the usage of its deprecated coordinates. Returns nil when the target has no
+ * valid schema version.
+ */
+func (hc *HiveClient) UnusedSchema(ctx context.Context, input *UnusedSchemaInput) (*UnusedSchema, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.UnusedSchema(ctx, *hc.client, *selector, client.DateRangeInput{From: input.From, To: input.To})
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	if data.Target == nil {
+		return nil, newError(ErrorKindNotFound, "target %s/%s/%s not found", selector.OrganizationSlug, selector.ProjectSlug, selector.TargetSlug)
+	}
+
+	version := data.Target.LatestValidSchemaVersion
+	if version == nil {
+		return nil, nil
+	}
+
+	result := UnusedSchema{
+		SchemaVersionId: version.GetId(),
+		Types:           []string{},
+		Coordinates:     []string{},
+		Deprecated:      []DeprecatedCoordinate{},
+	}
+
+	if version.UnusedSchema != nil {
+		for _, t := range version.UnusedSchema.GetTypes() {
+			name, usage, members := explorerType(t)
+			if !usage.IsUsed {
+				result.Types = append(result.Types, name)
+			}
+			for _, member := range members {
+				if !member.usage.IsUsed {
+					result.Coordinates = append(result.Coordinates, member.coordinate)
+				}
+			}
+		}
+	}
+
+	if version.DeprecatedSchema != nil {
+		for _, t := range version.DeprecatedSchema.GetTypes() {
+			_, _, members := explorerType(t)
+			for _, member := range members {
+				if !member.isDeprecated {
+					continue
+				}
+				clients := member.usage.UsedByClients
+				if clients == nil {
+					clients = []string{}
+				}
+				result.Deprecated = append(result.Deprecated, DeprecatedCoordinate{
+					Coordinate: member.coordinate,
+					Reason:     member.reason,
+					IsUsed:     member.usage.IsUsed,
+					Count:      int64(math.Round(member.usage.Total)),
+					Clients:    clients,
+				})
+			}
+		}
+	}
+
+	return &result, nil
+}
+
+// explorerType returns the name, usage and members of a type returned by the
+// schema explorer.
+func explorerType(t client.ExplorerNamedType) (string, client.ExplorerUsage, []explorerMember) {
+	named, ok := t.(interface {
+		GetName() string
+		GetUsage() client.ExplorerNamedTypeUsageSchemaCoordinateUsage
+	})
+	if !ok {
+		return "", client.ExplorerUsage{IsUsed: true}, nil
+	}
+
+	name := named.GetName()
+	usage := named.GetUsage()
+
+	var members []explorerMember
+	switch v := t.(type) {
+	case interface {
+		GetFields() []client.ExplorerNamedTypeFieldsGraphQLField
+	}:
+		for _, field := range v.GetFields() {
+			coordinate := name + "." + field.Name
+			members = append(members, explorerMember{
+				coordinate:   coordinate,
+				isDeprecated: field.IsDeprecated,
+				reason:       field.DeprecationReason,
+				usage:        field.Usage.ExplorerUsage,
+			})
+			for _, arg := range field.Args {
+				members = append(members, explorerMember{
+					coordinate:   coordinate + "." + arg.Name,
+					isDeprecated: arg.IsDeprecated,
+					reason:       arg.DeprecationReason,
+					usage:        arg.Usage.ExplorerUsage,
+				})
+			}
+		}
+
+	case interface {
+		GetFields() []client.ExplorerNamedTypeFieldsGraphQLInputField
+	}:
+		for _, field := range v.GetFields() {
+			members = append(members, explorerMember{
+				coordinate:   name + "." + field.Name,
+				isDeprecated: field.IsDeprecated,
+				reason:       field.DeprecationReason,
+				usage:        field.Usage.ExplorerUsage,
+			})
+		}
+
+	case interface {
+		GetValues() []client.ExplorerNamedTypeValuesGraphQLEnumValue
+	}:
+		for _, value := range v.GetValues() {
+			members = append(members, explorerMember{
+				coordinate:   name + "." + value.Name,
+				isDeprecated: value.IsDeprecated,
+				reason:       value.DeprecationReason,
+				usage:        value.Usage.ExplorerUsage,
+			})
+		}
+	}
+
+	return name, usage.ExplorerUsage, members
+}
@@ -0,0 +1,275 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+type SchemaCoordinateUsageInput struct {
+	Project string
+	Target  string
+	// Coordinates are the schema coordinates to return the usage of, in the
+	// Type.field or Type.field.argument format used by Hive.
+	Coordinates []string
+	From        time.Time
+	To          time.Time
+	// ClientLimit is the maximum number of clients returned per coordinate,
+	// 0 returns all clients.
+	ClientLimit int
+}
+
+type SchemaCoordinateUsage struct {
+	Coordinate string
+	// Count is the number of requests that used the coordinate.
+	Count int64
+	// Percentage is the percentage of all requests that used the coordinate.
+	Percentage float64
+	// Clients are the clients that used the coordinate the most, in order.
+	Clients []ClientUsage
+}
+
+type ClientUsage struct {
+	Name       string
+	Count      int64
+	Percentage float64
+}
+
+// SchemaCoordinate is a parsed schema coordinate. Argument is empty for
+// coordinates of fields.
+type SchemaCoordinate struct {
+	Type     string
+	Field    string
+	Argument string
+}
+
+// ParseSchemaCoordinate parses a coordinate in the Type.field or
+// Type.field.argument format.
+func ParseSchemaCoordinate(coordinate string) (SchemaCoordinate, error) {
+	parts := strings.Split(coordinate, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return SchemaCoordinate{}, fmt.Errorf("invalid schema coordinate %q, expected Type.field or Type.field.argument", coordinate)
+	}
+	for _, part := range parts {
+		if part == "" {
+			return SchemaCoordinate{}, fmt.Errorf("invalid schema coordinate %q, expected Type.field or Type.field.argument", coordinate)
+		}
+	}
+
+	result := SchemaCoordinate{Type: parts[0], Field: parts[1]}
+	if len(parts) == 3 {
+		result.Argument = parts[2]
+	}
+	return result, nil
+}
+
+/**
+ * SchemaCoordinateUsage() fetches how often each of the schema coordinates was
+ * requested within the period, and by which clients. The coordinates must
+ * exist in the latest valid schema version of the target.
+ */
+func (hc *HiveClient) SchemaCoordinateUsage(ctx context.Context, input *SchemaCoordinateUsageInput) ([]SchemaCoordinateUsage, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := make([]SchemaCoordinate, 0, len(input.Coordinates))
+	for _, coordinate := range input.Coordinates {
+		c, err := ParseSchemaCoordinate(coordinate)
+		if err != nil {
+			return nil, newError(ErrorKindValidation, "%s", err)
+		}
+		parsed = append(parsed, c)
+	}
+
+	if err := hc.checkCoordinatesExist(ctx, input, parsed); err != nil {
+		return nil, err
+	}
+
+	period := client.DateRangeInput{From: input.From, To: input.To}
+
+	fields := make([]client.FieldTypePairInput, 0, len(parsed))
+	for _, c := range parsed {
+		field := client.FieldTypePairInput{Type: c.Type, Field: c.Field}
+		if c.Argument != "" {
+			field.Argument = &c.Argument
+		}
+		fields = append(fields, field)
+	}
+
+	data, err := client.SchemaFieldListStats(ctx, *hc.client, client.FieldListStatsInput{
+		OrganizationSlug: selector.OrganizationSlug,
+		ProjectSlug:      selector.ProjectSlug,
+		TargetSlug:       selector.TargetSlug,
+		Period:           period,
+		Fields:           fields,
+	})
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	// The stats are matched on their coordinate, as Hive doesn't guarantee
+	// they are returned in the order of the fields.
+	stats := map[SchemaCoordinate]client.SchemaFieldListStatsFieldListStatsFieldStatsValues{}
+	for _, values := range data.FieldListStats {
+		key := SchemaCoordinate{Type: values.GetType(), Field: values.GetField()}
+		if values.GetArgument() != nil {
+			key.Argument = *values.GetArgument()
+		}
+		stats[key] = values
+	}
+
+	// The clients are only fetched for used coordinates.
+	used := []string{}
+	for i, coordinate := range input.Coordinates {
+		if stats[parsed[i]].Count > 0 {
+			used = append(used, coordinate)
+		}
+	}
+	clients, err := hc.coordinateClients(ctx, selector, period, used, input.ClientLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	result := []SchemaCoordinateUsage{}
+	for i, coordinate := range input.Coordinates {
+		values := stats[parsed[i]]
+		usage := SchemaCoordinateUsage{
+			Coordinate: coordinate,
+			Count:      values.GetCount(),
+			Percentage: values.GetPercentage(),
+			Clients:    []ClientUsage{},
+		}
+		if c, ok := clients[coordinate]; ok {
+			usage.Clients = c
+		}
+		result = append(result, usage)
+	}
+
+	return result, nil
+}
+
+// checkCoordinatesExist returns an error for the first coordinate that isn't
+// in the latest valid schema version of the target.
+func (hc *HiveClient) checkCoordinatesExist(ctx context.Context, input *SchemaCoordinateUsageInput, coordinates []SchemaCoordinate) error {
+	version, err := hc.SchemaVersion(ctx, &SchemaVersionInput{Project: input.Project, Target: input.Target, Valid: true})
+	if err != nil {
+		return err
+	}
+	if version == nil || version.SDL == nil {
+		return newError(ErrorKindNotFound, "no valid schema version found for target")
+	}
+
+	schema, err := indexSchema(*version.SDL)
+	if err != nil {
+		return fmt.Errorf("failed to parse the latest valid schema version: %w", err)
+	}
+
+	for i, c := range coordinates {
+		var field *ast.FieldDefinition
+		if def := schema.types[c.Type]; def != nil {
+			field = def.Fields.ForName(c.Field)
+		}
+		if field == nil || (c.Argument != "" && field.Arguments.ForName(c.Argument) == nil) {
+			return newError(ErrorKindValidation, "schema coordinate %s doesn't exist in the latest valid schema version", input.Coordinates[i])
+		}
+	}
+	return nil
+}
+
+// coordinateClientsQuery is the field fetching the clients of a coordinate.
+// The query has one of them per coordinate, aliased by its index.
+const coordinateClientsQuery = `c%[1]d: schemaCoordinateStats(selector: $c%[1]d) {
+    clients {
+      nodes {
+        name
+        count
+        percentage
+      }
+    }
+  }`
+
+// coordinateClientsInput is Hive's SchemaCoordinateStatsInput, which genqlient
+// doesn't generate as the query isn't a static operation.
+type coordinateClientsInput struct {
+	OrganizationSlug string                `json:"organizationSlug"`
+	ProjectSlug      string                `json:"projectSlug"`
+	TargetSlug       string                `json:"targetSlug"`
+	Period           client.DateRangeInput `json:"period"`
+	SchemaCoordinate string                `json:"schemaCoordinate"`
+}
+
+type coordinateClientsResponse struct {
+	Clients struct {
+		Nodes []struct {
+			Name       string  `json:"name"`
+			Count      float64 `json:"count"`
+			Percentage float64 `json:"percentage"`
+		} `json:"nodes"`
+	} `json:"clients"`
+}
+
+// coordinateClients returns the clients that requested each of the
+// coordinates the most, at most limit of them unless limit is 0. Hive only
+// returns the clients of a single coordinate per field, so the coordinates are
+// fetched in one request with an aliased field each.
+func (hc *HiveClient) coordinateClients(ctx context.Context, selector *client.TargetSelectorInput, period client.DateRangeInput, coordinates []string, limit int) (map[string][]ClientUsage, error) {
+	result := map[string][]ClientUsage{}
+	if len(coordinates) == 0 {
+		return result, nil
+	}
+
+	variables := map[string]coordinateClientsInput{}
+	definitions := make([]string, 0, len(coordinates))
+	fields := make([]string, 0, len(coordinates))
+	for i, coordinate := range coordinates {
+		variables[fmt.Sprintf("c%d", i)] = coordinateClientsInput{
+			OrganizationSlug: selector.OrganizationSlug,
+			ProjectSlug:      selector.ProjectSlug,
+			TargetSlug:       selector.TargetSlug,
+			Period:           period,
+			SchemaCoordinate: coordinate,
+		}
+		definitions = append(definitions, fmt.Sprintf("$c%d: SchemaCoordinateStatsInput!", i))
+		fields = append(fields, fmt.Sprintf(coordinateClientsQuery, i))
+	}
+
+	req := &graphql.Request{
+		OpName: "SchemaCoordinateClients",
+		Query: fmt.Sprintf("query SchemaCoordinateClients(%s) {\n  %s\n}",
+			strings.Join(definitions, ", "), strings.Join(fields, "\n  ")),
+		Variables: variables,
+	}
+	data := map[string]coordinateClientsResponse{}
+	if err := (*hc.client).MakeRequest(ctx, req, &graphql.Response{Data: &data}); err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	for i, coordinate := range coordinates {
+		clients := []ClientUsage{}
+		for _, node := range data[fmt.Sprintf("c%d", i)].Clients.Nodes {
+			clients = append(clients, ClientUsage{
+				Name:       node.Name,
+				Count:      int64(math.Round(node.Count)),
+				Percentage: node.Percentage,
+			})
+		}
+		sort.SliceStable(clients, func(i, j int) bool {
+			return clients[i].Count > clients[j].Count
+		})
+		if limit > 0 && len(clients) > limit {
+			clients = clients[:limit]
+		}
+		result[coordinate] = clients
+	}
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+// TestCoordinateClients checks that the clients of all coordinates are
+// fetched in a single request.
+func TestCoordinateClients(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		payload := struct {
+			Query     string                            `json:"query"`
+			Variables map[string]coordinateClientsInput `json:"variables"`
+		}{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("unexpected request body: %v", err)
+		}
+		if got := strings.Count(payload.Query, "schemaCoordinateStats("); got != 2 {
+			t.Errorf("got %d schemaCoordinateStats fields, want 2", got)
+		}
+		if got := payload.Variables["c1"].SchemaCoordinate; got != "Query.b" {
+			t.Errorf("got coordinate %q for c1, want Query.b", got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data": {
+			"c0": {"clients": {"nodes": [
+				{"name": "web", "count": 2, "percentage": 20},
+				{"name": "ios", "count": 8, "percentage": 80}
+			]}},
+			"c1": {"clients": {"nodes": []}}
+		}}`))
+	}))
+	defer server.Close()
+
+	hc := NewHiveClient(&http.Client{Transport: http.DefaultTransport}, server.URL, "", "", "", "token", "test")
+	got, err := hc.coordinateClients(context.Background(), &client.TargetSelectorInput{}, client.DateRangeInput{}, []string{"Query.a", "Query.b"}, 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string][]ClientUsage{
+		"Query.a": {{Name: "ios", Count: 8, Percentage: 80}},
+		"Query.b": {},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got clients %v, want %v", got, want)
+	}
+	if requests != 1 {
+		t.Errorf("got %d requests, want 1", requests)
+	}
+}
@@ -0,0 +1,126 @@
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+type SchemaVersionInput struct {
+	Project string
+	Target  string
+	// Id selects a specific schema version.
+	Id string
+	// ActionId selects the schema version created by the schema publish with
+	// this action id.
+	ActionId string
+	// Valid selects the latest valid schema version instead of the latest
+	// schema version, when neither Id nor ActionId is set.
+	Valid bool
+}
+
+type SchemaVersion struct {
+	Id           string
+	Date         time.Time
+	Valid        bool
+	IsComposable bool
+	SDL          *string
+	Supergraph   *string
+	Tags         []string
+	Schemas      []ServiceSchema
+}
+
+// ServiceSchema is the schema of a single service within a schema version.
+// The service name and URL are only set for composite schemas, such as
+// federated subgraphs.
+type ServiceSchema struct {
+	Id      string
+	Service *string
+	SDL     string
+	URL     *string
+}
+
+/**
+ * SchemaVersion() fetches a schema version of the target, or nil when there
+ * is no matching schema version.
+ */
+func (hc *HiveClient) SchemaVersion(ctx context.Context, input *SchemaVersionInput) (*SchemaVersion, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	var details *client.SchemaVersionDetails
+
+	switch {
+	case input.ActionId != "":
+		data, err := client.SchemaVersionForActionId(ctx, *hc.client, input.ActionId, &client.TargetReferenceInput{BySelector: *selector})
+		if err != nil {
+			return nil, wrapRequestError(err)
+		}
+		if data.SchemaVersionForActionId != nil {
+			details = &data.SchemaVersionForActionId.SchemaVersionDetails
+		}
+
+	case input.Id != "":
+		data, err := client.SchemaVersion(ctx, *hc.client, *selector, input.Id)
+		if err != nil {
+			return nil, wrapRequestError(err)
+		}
+		if data.Target == nil {
+			return nil, newError(ErrorKindNotFound, "target %s/%s/%s not found", selector.OrganizationSlug, selector.ProjectSlug, selector.TargetSlug)
+		}
+		if data.Target.SchemaVersion != nil {
+			details = &data.Target.SchemaVersion.SchemaVersionDetails
+		}
+
+	default:
+		data, err := client.LatestSchemaVersion(ctx, *hc.client, *selector)
+		if err != nil {
+			return nil, wrapRequestError(err)
+		}
+		if data.Target == nil {
+			return nil, newError(ErrorKindNotFound, "target %s/%s/%s not found", selector.OrganizationSlug, selector.ProjectSlug, selector.TargetSlug)
+		}
+		if input.Valid && data.Target.LatestValidSchemaVersion != nil {
+			details = &data.Target.LatestValidSchemaVersion.SchemaVersionDetails
+		}
+		if !input.Valid && data.Target.LatestSchemaVersion != nil {
+			details = &data.Target.LatestSchemaVersion.SchemaVersionDetails
+		}
+	}
+
+	if details == nil {
+		return nil, nil
+	}
+
+	result := SchemaVersion{
+		Id:           details.GetId(),
+		Date:         details.GetDate(),
+		Valid:        details.GetValid(),
+		IsComposable: details.GetIsComposable(),
+		SDL:          details.GetSdl(),
+		Supergraph:   details.GetSupergraph(),
+		Tags:         details.GetTags(),
+	}
+
+	for _, node := range details.Schemas.GetNodes() {
+		switch v := node.(type) {
+		case *client.SchemaVersionDetailsSchemasSchemaConnectionNodesSingleSchema:
+			result.Schemas = append(result.Schemas, ServiceSchema{
+				Id:  v.GetId(),
+				SDL: v.GetSource(),
+			})
+		case *client.SchemaVersionDetailsSchemasSchemaConnectionNodesCompositeSchema:
+			result.Schemas = append(result.Schemas, ServiceSchema{
+				Id:      v.GetId(),
+				Service: v.GetService(),
+				SDL:     v.GetSource(),
+				URL:     v.GetUrl(),
+			})
+		}
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,234 @@
+package sdk
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+type OperationsStatsInput struct {
+	Project string
+	Target  string
+	From    time.Time
+	To      time.Time
+	// ClientNames only includes the requests of these clients when set.
+	ClientNames []string
+	// Operations only includes the requests of the operations with these IDs
+	// when set.
+	Operations []string
+}
+
+type OperationsStats struct {
+	TotalRequests   int64
+	TotalFailures   int64
+	TotalOperations int
+	Duration        DurationStats
+	Operations      []OperationStats
+	Clients         []ClientStats
+}
+
+type ClientStatsInput struct {
+	Project string
+	Target  string
+	From    time.Time
+	To      time.Time
+}
+
+type ClientDetailsInput struct {
+	Project string
+	Target  string
+	From    time.Time
+	To      time.Time
+	Client  string
+}
+
+type ClientDetails struct {
+	TotalRequests int64
+	TotalVersions int64
+	Operations    []OperationStats
+}
+
+// DurationStats contains the average and percentiles of the request
+// durations, in milliseconds.
+type DurationStats struct {
+	Avg int
+	P75 int
+	P90 int
+	P95 int
+	P99 int
+}
+
+type OperationStats struct {
+	Id         string
+	Name       string
+	Kind       string
+	Hash       *string
+	Count      int64
+	CountOk    int64
+	Percentage float64
+	Duration   DurationStats
+}
+
+type ClientStats struct {
+	Name       string
+	Count      int64
+	Percentage float64
+	Versions   []ClientVersionStats
+}
+
+type ClientVersionStats struct {
+	Version    string
+	Count      int64
+	Percentage float64
+}
+
+/**
+ * OperationsStats() fetches the request statistics of the target within the
+ * period, in total and per operation and client.
+ */
+func (hc *HiveClient) OperationsStats(ctx context.Context, input *OperationsStatsInput) (*OperationsStats, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.OperationsStats(ctx, *hc.client, client.OperationsStatsSelectorInput{
+		OrganizationSlug: selector.OrganizationSlug,
+		ProjectSlug:      selector.ProjectSlug,
+		TargetSlug:       selector.TargetSlug,
+		Period:           client.DateRangeInput{From: input.From, To: input.To},
+		ClientNames:      input.ClientNames,
+		Operations:       input.Operations,
+	})
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	stats := data.OperationsStats
+	result := OperationsStats{
+		TotalRequests:   stats.GetTotalRequests(),
+		TotalFailures:   stats.GetTotalFailures(),
+		TotalOperations: stats.GetTotalOperations(),
+		Duration:        newDurationStats(stats.Duration.DurationDetails),
+		Operations:      []OperationStats{},
+		Clients:         []ClientStats{},
+	}
+
+	for _, node := range stats.Operations.GetNodes() {
+		result.Operations = append(result.Operations, newOperationStats(node.OperationStatsDetails))
+	}
+	for _, node := range stats.Clients.GetNodes() {
+		result.Clients = append(result.Clients, newClientStats(node.ClientStatsDetails))
+	}
+
+	return &result, nil
+}
+
+/**
+ * ClientStats() fetches the request statistics of the clients of the target
+ * within the period, including the versions of each client.
+ */
+func (hc *HiveClient) ClientStats(ctx context.Context, input *ClientStatsInput) ([]ClientStats, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := hc.GetTarget(ctx, &GetTargetInput{Project: input.Project, Target: input.Target})
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.ClientStatsByTargets(ctx, *hc.client, client.ClientStatsByTargetsInput{
+		OrganizationSlug: selector.OrganizationSlug,
+		ProjectSlug:      selector.ProjectSlug,
+		TargetIds:        []string{target.Id},
+		Period:           client.DateRangeInput{From: input.From, To: input.To},
+	})
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	result := []ClientStats{}
+	for _, node := range data.ClientStatsByTargets.GetNodes() {
+		result = append(result, newClientStats(node.ClientStatsDetails))
+	}
+
+	return result, nil
+}
+
+/**
+ * ClientDetails() fetches the request statistics of a single client of the
+ * target within the period, including the operations it sent.
+ */
+func (hc *HiveClient) ClientDetails(ctx context.Context, input *ClientDetailsInput) (*ClientDetails, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.ClientStats(ctx, *hc.client, client.ClientStatsInput{
+		OrganizationSlug: selector.OrganizationSlug,
+		ProjectSlug:      selector.ProjectSlug,
+		TargetSlug:       selector.TargetSlug,
+		Client:           input.Client,
+		Period:           client.DateRangeInput{From: input.From, To: input.To},
+	})
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	stats := data.ClientStats
+	result := ClientDetails{
+		TotalRequests: stats.GetTotalRequests(),
+		TotalVersions: stats.GetTotalVersions(),
+		Operations:    []OperationStats{},
+	}
+	for _, node := range stats.Operations.GetNodes() {
+		result.Operations = append(result.Operations, newOperationStats(node.OperationStatsDetails))
+	}
+
+	return &result, nil
+}
+
+func newDurationStats(duration client.DurationDetails) DurationStats {
+	return DurationStats{
+		Avg: duration.GetAvg(),
+		P75: duration.GetP75(),
+		P90: duration.GetP90(),
+		P95: duration.GetP95(),
+		P99: duration.GetP99(),
+	}
+}
+
+func newOperationStats(operation client.OperationStatsDetails) OperationStats {
+	return OperationStats{
+		Id:         operation.GetId(),
+		Name:       operation.GetName(),
+		Kind:       operation.GetKind(),
+		Hash:       operation.GetOperationHash(),
+		Count:      operation.GetCount(),
+		CountOk:    operation.GetCountOk(),
+		Percentage: operation.GetPercentage(),
+		Duration:   newDurationStats(operation.Duration.DurationDetails),
+	}
+}
+
+func newClientStats(stats client.ClientStatsDetails) ClientStats {
+	result := ClientStats{
+		Name:       stats.GetName(),
+		Count:      int64(math.Round(stats.GetCount())),
+		Percentage: stats.GetPercentage(),
+		Versions:   []ClientVersionStats{},
+	}
+	for _, version := range stats.GetVersions() {
+		result.Versions = append(result.Versions, ClientVersionStats{
+			Version:    version.GetVersion(),
+			Count:      int64(math.Round(version.GetCount())),
+			Percentage: version.GetPercentage(),
+		})
+	}
+	return result
+}
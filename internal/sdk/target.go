@@ -0,0 +1,105 @@
+package sdk
+
+import (
+	"context"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+type GetTargetInput struct {
+	Project string
+	Target  string
+}
+
+type ListTargetsInput struct {
+	Project string
+}
+
+type Target struct {
+	Id                 string
+	Slug               string
+	CdnUrl             string
+	GraphqlEndpointUrl *string
+	ValidationSettings TargetValidationSettings
+}
+
+// TargetValidationSettings contains the settings used to decide whether a
+// schema change is breaking based on the usage data.
+type TargetValidationSettings struct {
+	Enabled               bool
+	BreakingChangeFormula string
+	Percentage            float64
+	Period                int
+	RequestCount          int
+	ExcludedClients       []string
+	// Targets contains the slugs of the targets whose usage data is used.
+	Targets []string
+}
+
+/**
+ * GetTarget() fetches the details of the target.
+ */
+func (hc *HiveClient) GetTarget(ctx context.Context, input *GetTargetInput) (*Target, error) {
+	selector, err := hc.resolveTarget(ctx, input.Project, input.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.GetTarget(ctx, *hc.client, *selector)
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	if data.Target == nil {
+		return nil, newError(ErrorKindNotFound, "target %s/%s/%s not found", selector.OrganizationSlug, selector.ProjectSlug, selector.TargetSlug)
+	}
+
+	result := newTarget(&data.Target.TargetDetails)
+	return &result, nil
+}
+
+/**
+ * ListTargets() fetches the details of all targets of the project.
+ */
+func (hc *HiveClient) ListTargets(ctx context.Context, input *ListTargetsInput) ([]Target, error) {
+	selector, err := hc.resolveProject(ctx, input.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := client.ListTargets(ctx, *hc.client, *selector)
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	result := make([]Target, 0, len(data.Targets.Nodes))
+	for _, node := range data.Targets.Nodes {
+		result = append(result, newTarget(&node.TargetDetails))
+	}
+	return result, nil
+}
+
+func newTarget(details *client.TargetDetails) Target {
+	settings := details.GetValidationSettings()
+
+	result := Target{
+		Id:                 details.GetId(),
+		Slug:               details.GetSlug(),
+		CdnUrl:             details.GetCdnUrl(),
+		GraphqlEndpointUrl: details.GetGraphqlEndpointUrl(),
+		ValidationSettings: TargetValidationSettings{
+			Enabled:               settings.GetEnabled(),
+			BreakingChangeFormula: string(settings.GetBreakingChangeFormula()),
+			Percentage:            settings.GetPercentage(),
+			Period:                settings.GetPeriod(),
+			RequestCount:          settings.GetRequestCount(),
+			ExcludedClients:       settings.GetExcludedClients(),
+		},
+	}
+
+	for _, target := range settings.GetTargets() {
+		result.ValidationSettings.Targets = append(result.ValidationSettings.Targets, target.GetSlug())
+	}
+
+	return result
+}
@@ -0,0 +1,172 @@
+package sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/labd/terraform-provider-hive/internal/client"
+)
+
+const (
+	ScopeRegistryRead         = "target:registry:read"
+	ScopeRegistryWrite        = "target:registry:write"
+	ScopeTargetSettings       = "target:settings"
+	ScopeOrganizationSettings = "organization:settings"
+)
+
+// ErrTokenNotFound is returned when Hive doesn't recognize the token.
+var ErrTokenNotFound = errors.New("token not found")
+
+// TokenInfo describes the token the client authenticates with, and the
+// target it belongs to.
+type TokenInfo struct {
+	Name             string
+	OrganizationId   string
+	OrganizationSlug string
+	ProjectId        string
+	ProjectSlug      string
+	TargetId         string
+	TargetSlug       string
+	// Scopes contains whether the token has each of the Scope constants.
+	Scopes map[string]bool
+}
+
+// LoadTokenInfo fetches the token info and caches it on the client, so later
+// requests can use the target of the token without fetching it again.
+func (hc *HiveClient) LoadTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	hc.tokenInfoMu.Lock()
+	defer hc.tokenInfoMu.Unlock()
+	return hc.loadTokenInfo(ctx)
+}
+
+// loadTokenInfo fetches and caches the token info, the caller must hold
+// tokenInfoMu.
+func (hc *HiveClient) loadTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	data, err := client.TokenInfo(ctx, *hc.client)
+	if err != nil {
+		return nil, wrapRequestError(err)
+	}
+
+	switch v := data.TokenInfo.(type) {
+	case *client.TokenInfoTokenInfo:
+		hc.tokenInfo = &TokenInfo{
+			Name:             v.Token.GetName(),
+			OrganizationId:   v.Organization.GetId(),
+			OrganizationSlug: v.Organization.GetSlug(),
+			ProjectId:        v.Project.GetId(),
+			ProjectSlug:      v.Project.GetSlug(),
+			TargetId:         v.Target.GetId(),
+			TargetSlug:       v.Target.GetSlug(),
+			Scopes: map[string]bool{
+				ScopeRegistryRead:         v.GetRegistryRead(),
+				ScopeRegistryWrite:        v.GetRegistryWrite(),
+				ScopeTargetSettings:       v.GetTargetSettings(),
+				ScopeOrganizationSettings: v.GetOrganizationSettings(),
+			},
+		}
+		return hc.tokenInfo, nil
+
+	case *client.TokenInfoTokenInfoTokenNotFoundError:
+		return nil, &Error{
+			Kind:    ErrorKindAuth,
+			Message: fmt.Sprintf("%s: %s", ErrTokenNotFound, v.GetMessage()),
+			Err:     ErrTokenNotFound,
+		}
+	}
+
+	return nil, fmt.Errorf("unexpected type %T", data.TokenInfo)
+}
+
+// MissingScope reports whether the token is known to lack the scope on the
+// given target. The scopes are only known for the target the token belongs
+// to, so false is returned for any other target.
+func (hc *HiveClient) MissingScope(project string, target string, scope string) bool {
+	hc.tokenInfoMu.Lock()
+	info := hc.tokenInfo
+	hc.tokenInfoMu.Unlock()
+	if info == nil {
+		return false
+	}
+
+	organization, project, target := hc.TargetSlugs(project, target)
+	if organization != "" && (organization != info.OrganizationSlug || project != info.ProjectSlug || target != info.TargetSlug) {
+		return false
+	}
+
+	has, ok := info.Scopes[scope]
+	return ok && !has
+}
+
+// resolveTarget returns the selector for the given project and target. When
+// no slugs are set the target the token belongs to is used instead.
+func (hc *HiveClient) resolveTarget(ctx context.Context, project string, target string) (*client.TargetSelectorInput, error) {
+	ref, err := hc.getTarget(project, target)
+	if err != nil {
+		return nil, err
+	}
+	if ref != nil {
+		return &ref.BySelector, nil
+	}
+
+	info, err := hc.cachedTokenInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client.TargetSelectorInput{
+		OrganizationSlug: info.OrganizationSlug,
+		ProjectSlug:      info.ProjectSlug,
+		TargetSlug:       info.TargetSlug,
+	}, nil
+}
+
+// resolveProject returns the selector for the given project. When neither the
+// organization nor the project is set the project the token belongs to is
+// used instead.
+func (hc *HiveClient) resolveProject(ctx context.Context, project string) (*client.ProjectSelectorInput, error) {
+	if err := hc.ValidateProject(project); err != nil {
+		return nil, err
+	}
+
+	organization, project, _ := hc.TargetSlugs(project, "")
+	if organization == "" {
+		info, err := hc.cachedTokenInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		organization, project = info.OrganizationSlug, info.ProjectSlug
+	}
+
+	return &client.ProjectSelectorInput{
+		OrganizationSlug: organization,
+		ProjectSlug:      project,
+	}, nil
+}
+
+// resolveOrganization returns the selector for the organization, which is the
+// organization the token belongs to when none is set.
+func (hc *HiveClient) resolveOrganization(ctx context.Context) (*client.OrganizationSelectorInput, error) {
+	organization := hc.Organization
+	if organization == "" {
+		info, err := hc.cachedTokenInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		organization = info.OrganizationSlug
+	}
+
+	return &client.OrganizationSelectorInput{OrganizationSlug: organization}, nil
+}
+
+// cachedTokenInfo returns the token info, only fetching it when it isn't
+// loaded yet. Concurrent callers wait for a single fetch.
+func (hc *HiveClient) cachedTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	hc.tokenInfoMu.Lock()
+	defer hc.tokenInfoMu.Unlock()
+
+	if hc.tokenInfo != nil {
+		return hc.tokenInfo, nil
+	}
+	return hc.loadTokenInfo(ctx)
+}
@@ -0,0 +1,77 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const tokenInfoResponse = `{"data":{"tokenInfo":{
+	"__typename":"TokenInfo",
+	"organization":{"id":"o1","slug":"acme"},
+	"project":{"id":"p1","slug":"shop"},
+	"target":{"id":"t1","slug":"production"},
+	"token":{"name":"ci"},
+	"registryRead":true,
+	"registryWrite":false,
+	"targetSettings":false,
+	"organizationSettings":false
+}}}`
+
+// TestCachedTokenInfoConcurrent checks that resources and data sources can
+// load the token info at the same time after loading it failed during the
+// provider configuration. Run with -race to detect unsynchronized access.
+func TestCachedTokenInfoConcurrent(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(tokenInfoResponse))
+	}))
+	defer server.Close()
+
+	hc := NewHiveClient(&http.Client{Transport: http.DefaultTransport}, server.URL, "", "", "", "token", "test")
+
+	if _, err := hc.LoadTokenInfo(context.Background()); err == nil {
+		t.Fatal("expected loading the token info to fail")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			selector, err := hc.resolveTarget(context.Background(), "", "")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if selector.OrganizationSlug != "acme" || selector.ProjectSlug != "shop" || selector.TargetSlug != "production" {
+				t.Errorf("unexpected selector %+v", selector)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			// The scopes are either not loaded yet, or the token lacks the
+			// write scope.
+			_ = hc.MissingScope("", "", ScopeRegistryWrite)
+		}()
+	}
+	wg.Wait()
+
+	if got := requests.Load(); got != 2 {
+		t.Errorf("got %d token info requests, want 2", got)
+	}
+	if !hc.MissingScope("", "", ScopeRegistryWrite) {
+		t.Error("expected the registry write scope to be missing")
+	}
+	if hc.MissingScope("", "", ScopeRegistryRead) {
+		t.Error("expected the registry read scope to be present")
+	}
+}
@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TransportOptions configures the TLS and proxy settings of the transport
+// used to reach Hive, for example for self-hosted instances.
+type TransportOptions struct {
+	// CACertPEM contains additional PEM encoded CA certificates to trust next
+	// to the system certificates.
+	CACertPEM string
+	// ClientCertPEM and ClientKeyPEM contain the PEM encoded client
+	// certificate and key for mutual TLS.
+	ClientCertPEM string
+	ClientKeyPEM  string
+	// InsecureSkipVerify disables the verification of the server certificate.
+	InsecureSkipVerify bool
+	// ProxyURL overrides the proxy from the HTTP_PROXY and HTTPS_PROXY
+	// environment variables.
+	ProxyURL string
+}
+
+// NewTransport returns a copy of http.DefaultTransport with the options
+// applied.
+func NewTransport(options TransportOptions) (*http.Transport, error) {
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unexpected default transport type %T", http.DefaultTransport)
+	}
+	transport := defaultTransport.Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: options.InsecureSkipVerify,
+	}
+
+	if options.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(options.CACertPEM)) {
+			return nil, fmt.Errorf("failed to parse CA certificate: no PEM encoded certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if options.ClientCertPEM != "" || options.ClientKeyPEM != "" {
+		if options.ClientCertPEM == "" || options.ClientKeyPEM == "" {
+			return nil, fmt.Errorf("both a client certificate and key are required for mutual TLS")
+		}
+
+		certificate, err := tls.X509KeyPair([]byte(options.ClientCertPEM), []byte(options.ClientKeyPEM))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	if options.ProxyURL != "" {
+		proxy, err := url.Parse(options.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse proxy URL: %w", err)
+		}
+		if proxy.Scheme == "" || proxy.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL %q, expected a URL like http://proxy:3128", options.ProxyURL)
+		}
+		transport.Proxy = http.ProxyURL(proxy)
+	}
+
+	return transport, nil
+}
@@ -1,29 +1,95 @@
 package sdk
 
 import (
-	"context"
-	"regexp"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/labd/terraform-provider-hive/internal/client"
 )
 
-// minifySchema removes extra whitespace from the schema string.
-func minifySchema(schema string) string {
-	re := regexp.MustCompile(`\s+`)
-	return strings.TrimSpace(re.ReplaceAllString(schema, " "))
+// TargetSlugs returns the organization, project and target slugs, using the
+// defaults of the client for the empty project and target.
+func (hc *HiveClient) TargetSlugs(project string, target string) (string, string, string) {
+	if project == "" {
+		project = hc.Project
+	}
+	if target == "" {
+		target = hc.Target
+	}
+	return hc.Organization, project, target
 }
 
-func getTarget(ctx context.Context, organization string, project string, target string) *client.TargetReferenceInput {
-	if organization == "" || project == "" || target == "" {
+// ValidateTarget returns an error when the organization, project and target
+// only partially select a target. Either all of them are set, or none of them
+// and the target the token belongs to is used.
+func (hc *HiveClient) ValidateTarget(project string, target string) error {
+	organization, project, target := hc.TargetSlugs(project, target)
+
+	var set, missing []string
+	for _, slug := range []struct{ name, value string }{
+		{"organization", organization},
+		{"project", project},
+		{"target", target},
+	} {
+		if slug.value == "" {
+			missing = append(missing, slug.name)
+		} else {
+			set = append(set, slug.name)
+		}
+	}
+
+	if len(set) == 0 || len(missing) == 0 {
 		return nil
 	}
 
+	return fmt.Errorf(
+		"%s but %s, set all of organization, project and target, or none of them to use the target of the token",
+		describeSlugs(set, "set"), describeSlugs(missing, "missing"),
+	)
+}
+
+// ValidateProject returns an error when only one of the organization and
+// project is set. Either both are set, or neither of them and the project the
+// token belongs to is used.
+func (hc *HiveClient) ValidateProject(project string) error {
+	organization, project, _ := hc.TargetSlugs(project, "")
+
+	switch {
+	case organization != "" && project == "":
+		return errors.New("organization is set but project is missing, " +
+			"set both organization and project, or neither of them to use the project of the token")
+	case organization == "" && project != "":
+		return errors.New("project is set but organization is missing, " +
+			"set both organization and project, or neither of them to use the project of the token")
+	}
+	return nil
+}
+
+func describeSlugs(names []string, state string) string {
+	if len(names) == 1 {
+		return fmt.Sprintf("%s is %s", names[0], state)
+	}
+	return fmt.Sprintf("%s are %s", strings.Join(names, " and "), state)
+}
+
+// getTarget returns the reference to the target, or nil to use the target
+// of the token.
+func (hc *HiveClient) getTarget(project string, target string) (*client.TargetReferenceInput, error) {
+	if err := hc.ValidateTarget(project, target); err != nil {
+		return nil, err
+	}
+
+	organization, project, target := hc.TargetSlugs(project, target)
+	if organization == "" {
+		return nil, nil
+	}
+
 	return &client.TargetReferenceInput{
 		BySelector: client.TargetSelectorInput{
 			OrganizationSlug: organization,
 			ProjectSlug:      project,
 			TargetSlug:       target,
 		},
-	}
+	}, nil
 }